@@ -0,0 +1,119 @@
+// Package cache provides bounded key-value caches built on the doubly
+// linked nodes of deque.DequeueList, so every operation is O(1).
+package cache
+
+import (
+	"time"
+)
+
+// Cache is implemented by LRU and LFU, and wrapped by Synced to
+// share a cache between goroutines.
+type Cache[K comparable, V any] interface {
+	Capacity() uint
+	Clear()
+	Contains(key K) bool
+	Delete(key K) bool
+	Get(key K) (V, bool)
+	IsEmpty() bool
+	Length() uint
+	Peek(key K) (V, bool)
+	Put(key K, value V)
+	RemoveExpired() uint
+	Stats() Stats
+}
+
+// Config holds the optional settings of a cache.
+// The zero value disables expiry and eviction callbacks.
+type Config[K comparable, V any] struct {
+	// TTL is how long an entry stays valid after it was put.
+	// Zero means entries never expire.
+	TTL time.Duration
+
+	// Now returns the current time. It defaults to time.Now
+	// and can be replaced to control expiry in tests.
+	Now func() time.Time
+
+	// OnEvict is called with every entry that leaves the cache
+	// because it expired or to make room for a new entry. It is not
+	// called for Delete and Clear, and must not use the cache.
+	OnEvict func(key K, value V, reason EvictReason)
+}
+
+// EvictReason tells OnEvict why an entry left the cache.
+type EvictReason int
+
+const (
+	// Capacity means the entry was evicted to make room for a new entry.
+	Capacity EvictReason = iota
+	// Expired means the entry was found to be older than the TTL.
+	Expired
+)
+
+func (reason EvictReason) String() string {
+	switch reason {
+	case Capacity:
+		return "capacity"
+	case Expired:
+		return "expired"
+	default:
+		return "unknown"
+	}
+}
+
+// Stats counts the lookups and evictions of a cache.
+type Stats struct {
+	Hits        uint64
+	Misses      uint64
+	Evictions   uint64
+	Expirations uint64
+}
+
+// HitRatio returns the share of lookups that were hits,
+// or 0 if there were no lookups yet.
+func (stats Stats) HitRatio() float64 {
+	lookups := stats.Hits + stats.Misses
+	if lookups == 0 {
+		return 0
+	}
+	return float64(stats.Hits) / float64(lookups)
+}
+
+// expiry computes the expiry times of the entries of a cache
+// and reports evictions to the callback and the statistics.
+type expiry[K comparable, V any] struct {
+	config Config[K, V]
+	stats  Stats
+}
+
+func newExpiry[K comparable, V any](config Config[K, V]) expiry[K, V] {
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	return expiry[K, V]{
+		config: config,
+	}
+}
+
+// deadline returns when an entry put now expires,
+// or the zero time if entries never expire.
+func (e *expiry[K, V]) deadline() time.Time {
+	if e.config.TTL <= 0 {
+		return time.Time{}
+	}
+	return e.config.Now().Add(e.config.TTL)
+}
+
+func (e *expiry[K, V]) expired(deadline time.Time) bool {
+	return !deadline.IsZero() && !e.config.Now().Before(deadline)
+}
+
+func (e *expiry[K, V]) evicted(key K, value V, reason EvictReason) {
+	if reason == Expired {
+		e.stats.Expirations++
+	} else {
+		e.stats.Evictions++
+	}
+	if e.config.OnEvict != nil {
+		e.config.OnEvict(key, value, reason)
+	}
+}
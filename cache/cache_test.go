@@ -0,0 +1,71 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+var (
+	_ Cache[string, int] = (*LRU[string, int])(nil)
+	_ Cache[string, int] = (*LFU[string, int])(nil)
+	_ Cache[string, int] = (*Synced[string, int])(nil)
+)
+
+// fakeClock is a clock for the tests that only moves when advanced.
+type fakeClock struct {
+	now time.Time
+}
+
+func (clock *fakeClock) Now() time.Time {
+	return clock.now
+}
+
+func (clock *fakeClock) Advance(d time.Duration) {
+	clock.now = clock.now.Add(d)
+}
+
+// evictionLog records the calls of OnEvict.
+type evictionLog struct {
+	keys    []string
+	reasons []EvictReason
+}
+
+func (log *evictionLog) OnEvict(key string, value int, reason EvictReason) {
+	log.keys = append(log.keys, key)
+	log.reasons = append(log.reasons, reason)
+}
+
+type testStats struct {
+	name  string
+	input Stats
+	want  float64
+}
+
+func TestStatsHitRatio(t *testing.T) {
+	tests := []testStats{
+		{
+			name:  "Test hit ratio without lookups",
+			input: Stats{},
+			want:  0,
+		},
+		{
+			name:  "Test hit ratio with hits and misses",
+			input: Stats{Hits: 3, Misses: 1},
+			want:  0.75,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.HitRatio()
+			if got != tt.want {
+				t.Errorf("actual = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvictReasonString(t *testing.T) {
+	if Capacity.String() != "capacity" || Expired.String() != "expired" {
+		t.Errorf("actual = %v, %v, want capacity, expired", Capacity, Expired)
+	}
+}
@@ -0,0 +1,238 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/dukenmarga/gollection/deque"
+)
+
+// LFU is a cache that evicts the least frequently used entry when full,
+// and among those the least recently used one. Entries with the same
+// use count share a bucket, and the buckets are kept in a DequeueList
+// in ascending use count, so a use moves an entry to the next bucket
+// in O(1). LFU is not safe for concurrent use, see Synced.
+type LFU[K comparable, V any] struct {
+	capacity uint
+	entries  map[K]*deque.Element[*lfuEntry[K, V]]
+	buckets  *deque.DequeueList[*lfuBucket[K, V]]
+	expiry   expiry[K, V]
+}
+
+// lfuBucket holds the entries used frequency times,
+// from the most recently used at the head.
+type lfuBucket[K comparable, V any] struct {
+	frequency uint64
+	entries   *deque.DequeueList[*lfuEntry[K, V]]
+}
+
+type lfuEntry[K comparable, V any] struct {
+	key      K
+	value    V
+	deadline time.Time
+	bucket   *deque.Element[*lfuBucket[K, V]]
+}
+
+// NewLFU creates a cache holding at most capacity entries.
+// A capacity of 0 is unbounded.
+func NewLFU[K comparable, V any](capacity uint) *LFU[K, V] {
+	return NewLFUWith(capacity, Config[K, V]{})
+}
+
+// NewLFUWith creates a cache holding at most capacity entries,
+// with expiry and eviction callbacks set up by config.
+func NewLFUWith[K comparable, V any](capacity uint, config Config[K, V]) *LFU[K, V] {
+	return &LFU[K, V]{
+		capacity: capacity,
+		entries:  map[K]*deque.Element[*lfuEntry[K, V]]{},
+		buckets:  &deque.DequeueList[*lfuBucket[K, V]]{},
+		expiry:   newExpiry(config),
+	}
+}
+
+func (cache *LFU[K, V]) Capacity() uint {
+	return cache.capacity
+}
+
+// Clear removes all entries without calling OnEvict.
+// The statistics are kept.
+func (cache *LFU[K, V]) Clear() {
+	cache.entries = map[K]*deque.Element[*lfuEntry[K, V]]{}
+	cache.buckets.Clear()
+}
+
+// Contains reports whether the key is cached and not expired,
+// without counting a lookup or a use.
+func (cache *LFU[K, V]) Contains(key K) bool {
+	element, ok := cache.entries[key]
+	return ok && !cache.expiry.expired(element.Value().deadline)
+}
+
+// Delete removes the key without calling OnEvict,
+// and reports whether it was cached.
+func (cache *LFU[K, V]) Delete(key K) bool {
+	element, ok := cache.entries[key]
+	if !ok {
+		return false
+	}
+	cache.remove(element)
+	return true
+}
+
+// Frequency returns how many times the key was used,
+// counting the Put that added it.
+func (cache *LFU[K, V]) Frequency(key K) (uint64, bool) {
+	element, ok := cache.entries[key]
+	if !ok {
+		return 0, false
+	}
+	return element.Value().bucket.Value().frequency, true
+}
+
+// Get returns the value of the key and counts a use of the entry.
+// An expired entry is removed and counts as a miss.
+func (cache *LFU[K, V]) Get(key K) (V, bool) {
+	element, ok := cache.lookup(key)
+	if !ok {
+		cache.expiry.stats.Misses++
+		var empty V
+		return empty, false
+	}
+	cache.expiry.stats.Hits++
+	cache.use(element)
+	return element.Value().value, true
+}
+
+func (cache *LFU[K, V]) IsEmpty() bool {
+	return len(cache.entries) == 0
+}
+
+// Length returns the number of entries,
+// including expired ones not removed yet.
+func (cache *LFU[K, V]) Length() uint {
+	return uint(len(cache.entries))
+}
+
+// Peek returns the value of the key like Get, but without counting
+// the lookup or a use of the entry.
+func (cache *LFU[K, V]) Peek(key K) (V, bool) {
+	element, ok := cache.lookup(key)
+	if !ok {
+		var empty V
+		return empty, false
+	}
+	return element.Value().value, true
+}
+
+// Put adds the key or replaces its value, restarting its TTL. Replacing
+// a value counts as a use of the entry. When the cache is full, the least
+// frequently used entry is evicted first.
+func (cache *LFU[K, V]) Put(key K, value V) {
+	if element, ok := cache.entries[key]; ok {
+		entry := element.Value()
+		entry.value = value
+		entry.deadline = cache.expiry.deadline()
+		cache.use(element)
+		return
+	}
+
+	if cache.capacity > 0 && cache.Length() >= cache.capacity {
+		bucket := cache.buckets.Front().Value()
+		victim := bucket.entries.Back()
+		cache.remove(victim)
+		cache.expiry.evicted(victim.Value().key, victim.Value().value, Capacity)
+	}
+
+	// new entries start in the bucket of entries used once
+	first := cache.buckets.Front()
+	if first == nil || first.Value().frequency != 1 {
+		first = cache.buckets.PushLeft(&lfuBucket[K, V]{
+			frequency: 1,
+			entries:   &deque.DequeueList[*lfuEntry[K, V]]{},
+		})
+	}
+	entry := &lfuEntry[K, V]{
+		key:      key,
+		value:    value,
+		deadline: cache.expiry.deadline(),
+		bucket:   first,
+	}
+	cache.entries[key] = first.Value().entries.PushLeft(entry)
+}
+
+// RemoveExpired removes all expired entries and returns how many
+// were removed. Expired entries are otherwise only removed when
+// they are looked up.
+func (cache *LFU[K, V]) RemoveExpired() uint {
+	var removed uint
+	bucket := cache.buckets.Front()
+	for bucket != nil {
+		// the bucket is removed with its last entry
+		nextBucket := bucket.Next()
+		element := bucket.Value().entries.Front()
+		for element != nil {
+			next := element.Next()
+			if cache.expiry.expired(element.Value().deadline) {
+				cache.expire(element)
+				removed++
+			}
+			element = next
+		}
+		bucket = nextBucket
+	}
+	return removed
+}
+
+func (cache *LFU[K, V]) Stats() Stats {
+	return cache.expiry.stats
+}
+
+func (cache *LFU[K, V]) expire(element *deque.Element[*lfuEntry[K, V]]) {
+	cache.remove(element)
+	cache.expiry.evicted(element.Value().key, element.Value().value, Expired)
+}
+
+// lookup returns the element of the key,
+// removing it instead if it expired.
+func (cache *LFU[K, V]) lookup(key K) (*deque.Element[*lfuEntry[K, V]], bool) {
+	element, ok := cache.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if cache.expiry.expired(element.Value().deadline) {
+		cache.expire(element)
+		return nil, false
+	}
+	return element, true
+}
+
+// remove removes the entry from its bucket,
+// and the bucket from the cache once it is empty.
+func (cache *LFU[K, V]) remove(element *deque.Element[*lfuEntry[K, V]]) {
+	entry := element.Value()
+	bucket := entry.bucket.Value()
+	_, _ = bucket.entries.Remove(element)
+	if bucket.entries.IsEmpty() {
+		_, _ = cache.buckets.Remove(entry.bucket)
+	}
+	delete(cache.entries, entry.key)
+}
+
+// use moves the entry to the head of the bucket with the next frequency,
+// creating that bucket right after the current one if needed.
+func (cache *LFU[K, V]) use(element *deque.Element[*lfuEntry[K, V]]) {
+	entry := element.Value()
+	current := entry.bucket
+	frequency := current.Value().frequency + 1
+
+	next := current.Next()
+	if next == nil || next.Value().frequency != frequency {
+		next, _ = cache.buckets.InsertAfter(&lfuBucket[K, V]{
+			frequency: frequency,
+			entries:   &deque.DequeueList[*lfuEntry[K, V]]{},
+		}, current)
+	}
+
+	cache.remove(element)
+	entry.bucket = next
+	cache.entries[entry.key] = next.Value().entries.PushLeft(entry)
+}
@@ -0,0 +1,155 @@
+package cache
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+type testLFU struct {
+	name        string
+	capacity    uint
+	inputFunc   func(cache *LFU[string, int])
+	wantKeys    []string
+	wantEvicted []string
+}
+
+func TestLFU(t *testing.T) {
+	tests := []testLFU{
+		{
+			name:     "Test LFU evicts the least frequently used",
+			capacity: 2,
+			inputFunc: func(cache *LFU[string, int]) {
+				cache.Put("a", 1)
+				cache.Put("b", 2)
+				cache.Get("a")
+				cache.Get("a")
+				cache.Get("b")
+				cache.Put("c", 3)
+			},
+			wantKeys:    []string{"a", "c"},
+			wantEvicted: []string{"b"},
+		},
+		{
+			name:     "Test LFU breaks ties by recency",
+			capacity: 2,
+			inputFunc: func(cache *LFU[string, int]) {
+				cache.Put("a", 1)
+				cache.Put("b", 2)
+				cache.Get("b")
+				cache.Get("a")
+				cache.Put("c", 3)
+			},
+			wantKeys:    []string{"a", "c"},
+			wantEvicted: []string{"b"},
+		},
+		{
+			name:     "Test LFU new entries are evicted first",
+			capacity: 3,
+			inputFunc: func(cache *LFU[string, int]) {
+				cache.Put("a", 1)
+				cache.Put("b", 2)
+				cache.Get("a")
+				cache.Get("b")
+				cache.Put("c", 3)
+				cache.Put("d", 4)
+			},
+			wantKeys:    []string{"a", "b", "d"},
+			wantEvicted: []string{"c"},
+		},
+		{
+			name:     "Test LFU peek does not count a use",
+			capacity: 2,
+			inputFunc: func(cache *LFU[string, int]) {
+				cache.Put("a", 1)
+				cache.Put("b", 2)
+				cache.Peek("a")
+				cache.Put("c", 3)
+			},
+			wantKeys:    []string{"b", "c"},
+			wantEvicted: []string{"a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := &evictionLog{}
+			cache := NewLFUWith(tt.capacity, Config[string, int]{
+				OnEvict: log.OnEvict,
+			})
+			tt.inputFunc(cache)
+
+			keys := []string{}
+			for _, key := range []string{"a", "b", "c", "d"} {
+				if cache.Contains(key) {
+					keys = append(keys, key)
+				}
+			}
+			if !slices.Equal(keys, tt.wantKeys) {
+				t.Errorf("actual = %v, want %v", keys, tt.wantKeys)
+			}
+			if !slices.Equal(log.keys, tt.wantEvicted) {
+				t.Errorf("actual evicted = %v, want %v", log.keys, tt.wantEvicted)
+			}
+		})
+	}
+}
+
+func TestLFUFrequency(t *testing.T) {
+	cache := NewLFU[string, int](0)
+	cache.Put("a", 1)
+	cache.Get("a")
+	cache.Put("a", 2)
+	cache.Put("b", 1)
+
+	if frequency, _ := cache.Frequency("a"); frequency != 3 {
+		t.Errorf("actual = %v, want %v", frequency, 3)
+	}
+	if frequency, _ := cache.Frequency("b"); frequency != 1 {
+		t.Errorf("actual = %v, want %v", frequency, 1)
+	}
+	if _, ok := cache.Frequency("c"); ok {
+		t.Errorf("expected no frequency for a missing key")
+	}
+
+	// Deleting the only entry of a bucket removes the bucket
+	cache.Delete("b")
+	if cache.buckets.Length() != 1 {
+		t.Errorf("actual buckets = %v, want %v", cache.buckets.Length(), 1)
+	}
+	if value, _ := cache.Get("a"); value != 2 {
+		t.Errorf("actual = %v, want %v", value, 2)
+	}
+}
+
+func TestLFUExpiry(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	log := &evictionLog{}
+	cache := NewLFUWith(2, Config[string, int]{
+		TTL:     time.Minute,
+		Now:     clock.Now,
+		OnEvict: log.OnEvict,
+	})
+
+	cache.Put("a", 1)
+	cache.Get("a")
+	clock.Advance(30 * time.Second)
+	cache.Put("b", 2)
+	clock.Advance(30 * time.Second)
+
+	if cache.Contains("a") {
+		t.Errorf("expected the key to be expired")
+	}
+	if removed := cache.RemoveExpired(); removed != 1 {
+		t.Errorf("actual removed = %v, want %v", removed, 1)
+	}
+	if value, ok := cache.Get("b"); !ok || value != 2 {
+		t.Errorf("actual = %v, %v, want %v, %v", value, ok, 2, true)
+	}
+	want := Stats{Hits: 2, Expirations: 1}
+	if cache.Stats() != want {
+		t.Errorf("actual = %+v, want %+v", cache.Stats(), want)
+	}
+	if !slices.Equal(log.keys, []string{"a"}) || log.reasons[0] != Expired {
+		t.Errorf("actual evicted = %v %v, want [a] [expired]", log.keys, log.reasons)
+	}
+}
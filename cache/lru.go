@@ -0,0 +1,174 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/dukenmarga/gollection/deque"
+)
+
+// LRU is a cache that evicts the least recently used entry when full.
+// The entries are kept in a DequeueList from the most recently used at
+// the head to the least recently used at the tail, and the map points
+// at their elements so a lookup can move the entry to the head in O(1).
+// LRU is not safe for concurrent use, see Synced.
+type LRU[K comparable, V any] struct {
+	capacity uint
+	entries  map[K]*deque.Element[lruEntry[K, V]]
+	order    *deque.DequeueList[lruEntry[K, V]]
+	expiry   expiry[K, V]
+}
+
+type lruEntry[K comparable, V any] struct {
+	key      K
+	value    V
+	deadline time.Time
+}
+
+// NewLRU creates a cache holding at most capacity entries.
+// A capacity of 0 is unbounded.
+func NewLRU[K comparable, V any](capacity uint) *LRU[K, V] {
+	return NewLRUWith(capacity, Config[K, V]{})
+}
+
+// NewLRUWith creates a cache holding at most capacity entries,
+// with expiry and eviction callbacks set up by config.
+func NewLRUWith[K comparable, V any](capacity uint, config Config[K, V]) *LRU[K, V] {
+	return &LRU[K, V]{
+		capacity: capacity,
+		entries:  map[K]*deque.Element[lruEntry[K, V]]{},
+		order:    &deque.DequeueList[lruEntry[K, V]]{},
+		expiry:   newExpiry(config),
+	}
+}
+
+func (cache *LRU[K, V]) Capacity() uint {
+	return cache.capacity
+}
+
+// Clear removes all entries without calling OnEvict.
+// The statistics are kept.
+func (cache *LRU[K, V]) Clear() {
+	cache.entries = map[K]*deque.Element[lruEntry[K, V]]{}
+	cache.order.Clear()
+}
+
+// Contains reports whether the key is cached and not expired,
+// without counting a lookup or marking the entry as used.
+func (cache *LRU[K, V]) Contains(key K) bool {
+	element, ok := cache.entries[key]
+	return ok && !cache.expiry.expired(element.Value().deadline)
+}
+
+// Delete removes the key without calling OnEvict,
+// and reports whether it was cached.
+func (cache *LRU[K, V]) Delete(key K) bool {
+	element, ok := cache.entries[key]
+	if !ok {
+		return false
+	}
+	cache.remove(element)
+	return true
+}
+
+// Get returns the value of the key and marks the entry as the most
+// recently used. An expired entry is removed and counts as a miss.
+func (cache *LRU[K, V]) Get(key K) (V, bool) {
+	element, ok := cache.lookup(key)
+	if !ok {
+		cache.expiry.stats.Misses++
+		var empty V
+		return empty, false
+	}
+	cache.expiry.stats.Hits++
+	_ = cache.order.MoveToFront(element)
+	return element.Value().value, true
+}
+
+func (cache *LRU[K, V]) IsEmpty() bool {
+	return len(cache.entries) == 0
+}
+
+// Length returns the number of entries,
+// including expired ones not removed yet.
+func (cache *LRU[K, V]) Length() uint {
+	return uint(len(cache.entries))
+}
+
+// Peek returns the value of the key like Get, but without counting
+// the lookup or marking the entry as used.
+func (cache *LRU[K, V]) Peek(key K) (V, bool) {
+	element, ok := cache.lookup(key)
+	if !ok {
+		var empty V
+		return empty, false
+	}
+	return element.Value().value, true
+}
+
+// Put adds the key or replaces its value, restarting its TTL, and marks
+// the entry as the most recently used. When the cache is full, the least
+// recently used entry is evicted first.
+func (cache *LRU[K, V]) Put(key K, value V) {
+	entry := lruEntry[K, V]{
+		key:      key,
+		value:    value,
+		deadline: cache.expiry.deadline(),
+	}
+	if element, ok := cache.entries[key]; ok {
+		element.SetValue(entry)
+		_ = cache.order.MoveToFront(element)
+		return
+	}
+
+	if cache.capacity > 0 && cache.Length() >= cache.capacity {
+		oldest := cache.order.Back()
+		cache.remove(oldest)
+		cache.expiry.evicted(oldest.Value().key, oldest.Value().value, Capacity)
+	}
+	cache.entries[key] = cache.order.PushLeft(entry)
+}
+
+// RemoveExpired removes all expired entries and returns how many
+// were removed. Expired entries are otherwise only removed when
+// they are looked up.
+func (cache *LRU[K, V]) RemoveExpired() uint {
+	var removed uint
+	element := cache.order.Front()
+	for element != nil {
+		next := element.Next()
+		if cache.expiry.expired(element.Value().deadline) {
+			cache.expire(element)
+			removed++
+		}
+		element = next
+	}
+	return removed
+}
+
+func (cache *LRU[K, V]) Stats() Stats {
+	return cache.expiry.stats
+}
+
+func (cache *LRU[K, V]) expire(element *deque.Element[lruEntry[K, V]]) {
+	cache.remove(element)
+	cache.expiry.evicted(element.Value().key, element.Value().value, Expired)
+}
+
+// lookup returns the element of the key,
+// removing it instead if it expired.
+func (cache *LRU[K, V]) lookup(key K) (*deque.Element[lruEntry[K, V]], bool) {
+	element, ok := cache.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if cache.expiry.expired(element.Value().deadline) {
+		cache.expire(element)
+		return nil, false
+	}
+	return element, true
+}
+
+func (cache *LRU[K, V]) remove(element *deque.Element[lruEntry[K, V]]) {
+	delete(cache.entries, element.Value().key)
+	_, _ = cache.order.Remove(element)
+}
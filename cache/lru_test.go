@@ -0,0 +1,183 @@
+package cache
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+type testLRU struct {
+	name        string
+	capacity    uint
+	inputFunc   func(cache *LRU[string, int])
+	wantKeys    []string
+	wantEvicted []string
+}
+
+func TestLRU(t *testing.T) {
+	tests := []testLRU{
+		{
+			name:     "Test LRU evicts the least recently put",
+			capacity: 2,
+			inputFunc: func(cache *LRU[string, int]) {
+				cache.Put("a", 1)
+				cache.Put("b", 2)
+				cache.Put("c", 3)
+			},
+			wantKeys:    []string{"b", "c"},
+			wantEvicted: []string{"a"},
+		},
+		{
+			name:     "Test LRU get marks the entry as used",
+			capacity: 2,
+			inputFunc: func(cache *LRU[string, int]) {
+				cache.Put("a", 1)
+				cache.Put("b", 2)
+				cache.Get("a")
+				cache.Put("c", 3)
+			},
+			wantKeys:    []string{"a", "c"},
+			wantEvicted: []string{"b"},
+		},
+		{
+			name:     "Test LRU peek does not mark the entry as used",
+			capacity: 2,
+			inputFunc: func(cache *LRU[string, int]) {
+				cache.Put("a", 1)
+				cache.Put("b", 2)
+				cache.Peek("a")
+				cache.Put("c", 3)
+			},
+			wantKeys:    []string{"b", "c"},
+			wantEvicted: []string{"a"},
+		},
+		{
+			name:     "Test LRU replacing a value marks the entry as used",
+			capacity: 2,
+			inputFunc: func(cache *LRU[string, int]) {
+				cache.Put("a", 1)
+				cache.Put("b", 2)
+				cache.Put("a", 10)
+				cache.Put("c", 3)
+			},
+			wantKeys:    []string{"a", "c"},
+			wantEvicted: []string{"b"},
+		},
+		{
+			name:     "Test LRU delete does not call OnEvict",
+			capacity: 2,
+			inputFunc: func(cache *LRU[string, int]) {
+				cache.Put("a", 1)
+				cache.Put("b", 2)
+				cache.Delete("a")
+				cache.Put("c", 3)
+			},
+			wantKeys:    []string{"b", "c"},
+			wantEvicted: nil,
+		},
+		{
+			name:     "Test LRU with zero capacity is unbounded",
+			capacity: 0,
+			inputFunc: func(cache *LRU[string, int]) {
+				cache.Put("a", 1)
+				cache.Put("b", 2)
+				cache.Put("c", 3)
+			},
+			wantKeys:    []string{"a", "b", "c"},
+			wantEvicted: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := &evictionLog{}
+			cache := NewLRUWith(tt.capacity, Config[string, int]{
+				OnEvict: log.OnEvict,
+			})
+			tt.inputFunc(cache)
+
+			keys := []string{}
+			for _, key := range []string{"a", "b", "c"} {
+				if cache.Contains(key) {
+					keys = append(keys, key)
+				}
+			}
+			if !slices.Equal(keys, tt.wantKeys) {
+				t.Errorf("actual = %v, want %v", keys, tt.wantKeys)
+			}
+			if cache.Length() != uint(len(tt.wantKeys)) {
+				t.Errorf("actual length = %v, want length %v", cache.Length(), len(tt.wantKeys))
+			}
+			if !slices.Equal(log.keys, tt.wantEvicted) {
+				t.Errorf("actual evicted = %v, want %v", log.keys, tt.wantEvicted)
+			}
+		})
+	}
+}
+
+func TestLRUStats(t *testing.T) {
+	cache := NewLRU[string, int](2)
+	cache.Put("a", 1)
+	if value, ok := cache.Get("a"); !ok || value != 1 {
+		t.Errorf("actual = %v, %v, want %v, %v", value, ok, 1, true)
+	}
+	if _, ok := cache.Get("b"); ok {
+		t.Errorf("expected a miss for a missing key")
+	}
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+
+	want := Stats{Hits: 1, Misses: 1, Evictions: 1}
+	if cache.Stats() != want {
+		t.Errorf("actual = %+v, want %+v", cache.Stats(), want)
+	}
+
+	cache.Clear()
+	if !cache.IsEmpty() || cache.Stats() != want {
+		t.Errorf("Clear should remove the entries and keep the statistics")
+	}
+}
+
+func TestLRUExpiry(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	log := &evictionLog{}
+	cache := NewLRUWith(0, Config[string, int]{
+		TTL:     time.Minute,
+		Now:     clock.Now,
+		OnEvict: log.OnEvict,
+	})
+
+	cache.Put("a", 1)
+	clock.Advance(30 * time.Second)
+	cache.Put("b", 2)
+
+	// Reading does not extend the TTL, putting does
+	clock.Advance(20 * time.Second)
+	cache.Get("a")
+	cache.Put("b", 20)
+	clock.Advance(10 * time.Second)
+
+	if _, ok := cache.Get("a"); ok {
+		t.Errorf("expected a miss for an expired key")
+	}
+	if value, ok := cache.Get("b"); !ok || value != 20 {
+		t.Errorf("actual = %v, %v, want %v, %v", value, ok, 20, true)
+	}
+	if cache.Length() != 1 {
+		t.Errorf("actual length = %v, want length %v", cache.Length(), 1)
+	}
+
+	clock.Advance(time.Minute)
+	cache.Put("c", 3)
+	if removed := cache.RemoveExpired(); removed != 1 {
+		t.Errorf("actual removed = %v, want %v", removed, 1)
+	}
+	if !slices.Equal(log.keys, []string{"a", "b"}) {
+		t.Errorf("actual evicted = %v, want %v", log.keys, []string{"a", "b"})
+	}
+	if log.reasons[0] != Expired || log.reasons[1] != Expired {
+		t.Errorf("actual reasons = %v, want expired", log.reasons)
+	}
+	if cache.Stats().Expirations != 2 {
+		t.Errorf("actual expirations = %v, want %v", cache.Stats().Expirations, 2)
+	}
+}
@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"sync"
+)
+
+// Synced makes a cache safe for concurrent use by holding a mutex
+// for every call. A lookup changes the order of the entries, so
+// lookups are serialized as well. The OnEvict callback of the
+// wrapped cache runs while the mutex is held.
+type Synced[K comparable, V any] struct {
+	mu    sync.Mutex
+	cache Cache[K, V]
+}
+
+func NewSynced[K comparable, V any](cache Cache[K, V]) *Synced[K, V] {
+	return &Synced[K, V]{
+		cache: cache,
+	}
+}
+
+func (synced *Synced[K, V]) Capacity() uint {
+	synced.mu.Lock()
+	defer synced.mu.Unlock()
+	return synced.cache.Capacity()
+}
+
+func (synced *Synced[K, V]) Clear() {
+	synced.mu.Lock()
+	defer synced.mu.Unlock()
+	synced.cache.Clear()
+}
+
+func (synced *Synced[K, V]) Contains(key K) bool {
+	synced.mu.Lock()
+	defer synced.mu.Unlock()
+	return synced.cache.Contains(key)
+}
+
+func (synced *Synced[K, V]) Delete(key K) bool {
+	synced.mu.Lock()
+	defer synced.mu.Unlock()
+	return synced.cache.Delete(key)
+}
+
+func (synced *Synced[K, V]) Get(key K) (V, bool) {
+	synced.mu.Lock()
+	defer synced.mu.Unlock()
+	return synced.cache.Get(key)
+}
+
+// GetOrPut returns the value of the key, or computes it with fn and
+// puts it if the key is missing. The mutex is held while fn runs, so
+// the value is computed only once for concurrent callers.
+func (synced *Synced[K, V]) GetOrPut(key K, fn func() V) V {
+	synced.mu.Lock()
+	defer synced.mu.Unlock()
+	if value, ok := synced.cache.Get(key); ok {
+		return value
+	}
+	value := fn()
+	synced.cache.Put(key, value)
+	return value
+}
+
+func (synced *Synced[K, V]) IsEmpty() bool {
+	synced.mu.Lock()
+	defer synced.mu.Unlock()
+	return synced.cache.IsEmpty()
+}
+
+func (synced *Synced[K, V]) Length() uint {
+	synced.mu.Lock()
+	defer synced.mu.Unlock()
+	return synced.cache.Length()
+}
+
+func (synced *Synced[K, V]) Peek(key K) (V, bool) {
+	synced.mu.Lock()
+	defer synced.mu.Unlock()
+	return synced.cache.Peek(key)
+}
+
+func (synced *Synced[K, V]) Put(key K, value V) {
+	synced.mu.Lock()
+	defer synced.mu.Unlock()
+	synced.cache.Put(key, value)
+}
+
+func (synced *Synced[K, V]) RemoveExpired() uint {
+	synced.mu.Lock()
+	defer synced.mu.Unlock()
+	return synced.cache.RemoveExpired()
+}
+
+func (synced *Synced[K, V]) Stats() Stats {
+	synced.mu.Lock()
+	defer synced.mu.Unlock()
+	return synced.cache.Stats()
+}
@@ -0,0 +1,64 @@
+package cache
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSynced(t *testing.T) {
+	caches := map[string]Cache[int, int]{
+		"LRU": NewLRU[int, int](64),
+		"LFU": NewLFU[int, int](64),
+	}
+	for name, inner := range caches {
+		t.Run(name, func(t *testing.T) {
+			cache := NewSynced(inner)
+
+			var wg sync.WaitGroup
+			for worker := 0; worker < 8; worker++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for i := 0; i < 1000; i++ {
+						key := (worker*31 + i) % 100
+						cache.Put(key, key)
+						if value, ok := cache.Get(key); ok && value != key {
+							t.Errorf("actual = %v, want %v", value, key)
+						}
+						cache.Delete(key + 1)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if cache.Length() > cache.Capacity() {
+				t.Errorf("actual length = %v, want at most %v", cache.Length(), cache.Capacity())
+			}
+		})
+	}
+}
+
+func TestSyncedGetOrPut(t *testing.T) {
+	cache := NewSynced[string, int](NewLRU[string, int](2))
+	calls := 0
+	compute := func() int {
+		calls++
+		return 42
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if value := cache.GetOrPut("answer", compute); value != 42 {
+				t.Errorf("actual = %v, want %v", value, 42)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("actual calls = %v, want %v", calls, 1)
+	}
+}
@@ -0,0 +1,248 @@
+// Package codec contains the value codecs and the framing shared by the
+// binary encodings of the collections in this module.
+package codec
+
+import (
+	"encoding"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"math"
+)
+
+// Codec appends the binary form of a value to a buffer and decodes it back.
+// Decode returns the number of bytes consumed from data, so values can be
+// stored back to back without any extra framing.
+type Codec[T any] interface {
+	Append(buf []byte, value T) ([]byte, error)
+	Decode(data []byte) (T, int, error)
+}
+
+// Default returns the codec used by MarshalBinary/UnmarshalBinary.
+// It supports booleans, integers, floats, strings, byte slices and any
+// type whose pointer implements encoding.BinaryMarshaler and
+// encoding.BinaryUnmarshaler. Other types return an error when used.
+func Default[T any]() Codec[T] {
+	return defaultCodec[T]{}
+}
+
+type defaultCodec[T any] struct{}
+
+func (defaultCodec[T]) Append(buf []byte, value T) ([]byte, error) {
+	switch v := any(value).(type) {
+	case bool:
+		if v {
+			return append(buf, 1), nil
+		}
+		return append(buf, 0), nil
+	case int:
+		return binary.AppendVarint(buf, int64(v)), nil
+	case int8:
+		return binary.AppendVarint(buf, int64(v)), nil
+	case int16:
+		return binary.AppendVarint(buf, int64(v)), nil
+	case int32:
+		return binary.AppendVarint(buf, int64(v)), nil
+	case int64:
+		return binary.AppendVarint(buf, v), nil
+	case uint:
+		return binary.AppendUvarint(buf, uint64(v)), nil
+	case uint8:
+		return binary.AppendUvarint(buf, uint64(v)), nil
+	case uint16:
+		return binary.AppendUvarint(buf, uint64(v)), nil
+	case uint32:
+		return binary.AppendUvarint(buf, uint64(v)), nil
+	case uint64:
+		return binary.AppendUvarint(buf, v), nil
+	case uintptr:
+		return binary.AppendUvarint(buf, uint64(v)), nil
+	case float32:
+		return binary.LittleEndian.AppendUint32(buf, math.Float32bits(v)), nil
+	case float64:
+		return binary.LittleEndian.AppendUint64(buf, math.Float64bits(v)), nil
+	case string:
+		buf = binary.AppendUvarint(buf, uint64(len(v)))
+		return append(buf, v...), nil
+	case []byte:
+		buf = binary.AppendUvarint(buf, uint64(len(v)))
+		return append(buf, v...), nil
+	}
+
+	marshaler, ok := any(&value).(encoding.BinaryMarshaler)
+	if !ok {
+		return buf, fmt.Errorf("no binary codec for type %T", value)
+	}
+	data, err := marshaler.MarshalBinary()
+	if err != nil {
+		return buf, fmt.Errorf("%w", err)
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(data)))
+	return append(buf, data...), nil
+}
+
+func (defaultCodec[T]) Decode(data []byte) (T, int, error) {
+	var value T
+	var n int
+	var err error
+
+	switch v := any(&value).(type) {
+	case *bool:
+		if len(data) < 1 {
+			return value, 0, errShortBuffer
+		}
+		*v, n = data[0] != 0, 1
+	case *int:
+		var x int64
+		x, n, err = varint(data)
+		*v = int(x)
+	case *int8:
+		var x int64
+		x, n, err = varint(data)
+		*v = int8(x)
+	case *int16:
+		var x int64
+		x, n, err = varint(data)
+		*v = int16(x)
+	case *int32:
+		var x int64
+		x, n, err = varint(data)
+		*v = int32(x)
+	case *int64:
+		*v, n, err = varint(data)
+	case *uint:
+		var x uint64
+		x, n, err = uvarint(data)
+		*v = uint(x)
+	case *uint8:
+		var x uint64
+		x, n, err = uvarint(data)
+		*v = uint8(x)
+	case *uint16:
+		var x uint64
+		x, n, err = uvarint(data)
+		*v = uint16(x)
+	case *uint32:
+		var x uint64
+		x, n, err = uvarint(data)
+		*v = uint32(x)
+	case *uint64:
+		*v, n, err = uvarint(data)
+	case *uintptr:
+		var x uint64
+		x, n, err = uvarint(data)
+		*v = uintptr(x)
+	case *float32:
+		if len(data) < 4 {
+			return value, 0, errShortBuffer
+		}
+		*v, n = math.Float32frombits(binary.LittleEndian.Uint32(data)), 4
+	case *float64:
+		if len(data) < 8 {
+			return value, 0, errShortBuffer
+		}
+		*v, n = math.Float64frombits(binary.LittleEndian.Uint64(data)), 8
+	case *string:
+		var raw []byte
+		raw, n, err = lengthPrefixed(data)
+		*v = string(raw)
+	case *[]byte:
+		var raw []byte
+		raw, n, err = lengthPrefixed(data)
+		*v = append([]byte(nil), raw...)
+	case encoding.BinaryUnmarshaler:
+		var raw []byte
+		raw, n, err = lengthPrefixed(data)
+		if err == nil {
+			err = v.UnmarshalBinary(raw)
+		}
+	default:
+		return value, 0, fmt.Errorf("no binary codec for type %T", value)
+	}
+	if err != nil {
+		return value, 0, fmt.Errorf("%w", err)
+	}
+	return value, n, nil
+}
+
+var errShortBuffer = fmt.Errorf("unexpected end of data")
+
+func varint(data []byte) (int64, int, error) {
+	x, n := binary.Varint(data)
+	if n <= 0 {
+		return 0, 0, errShortBuffer
+	}
+	return x, n, nil
+}
+
+func uvarint(data []byte) (uint64, int, error) {
+	x, n := binary.Uvarint(data)
+	if n <= 0 {
+		return 0, 0, errShortBuffer
+	}
+	return x, n, nil
+}
+
+func lengthPrefixed(data []byte) ([]byte, int, error) {
+	length, n, err := uvarint(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if uint64(len(data)-n) < length {
+		return nil, 0, errShortBuffer
+	}
+	end := n + int(length)
+	return data[n:end], end, nil
+}
+
+// Version is the version of the binary format written by AppendHeader.
+const Version byte = 1
+
+// Kinds of collection stored in a binary frame.
+const (
+	KindDeque byte = 'd'
+	KindAVL   byte = 'a'
+	KindBST   byte = 'b'
+)
+
+var magic = [3]byte{'G', 'L', 'C'}
+
+// AppendHeader starts a binary frame holding count entries of
+// the given kind of collection.
+func AppendHeader(buf []byte, kind byte, count uint64) []byte {
+	buf = append(buf, magic[:]...)
+	buf = append(buf, Version, kind)
+	return binary.AppendUvarint(buf, count)
+}
+
+// Seal ends a binary frame by appending the CRC-32 checksum
+// of everything written so far.
+func Seal(buf []byte) []byte {
+	return binary.LittleEndian.AppendUint32(buf, crc32.ChecksumIEEE(buf))
+}
+
+// Open checks the checksum and the header of a binary frame and
+// returns the number of entries and the encoded entries.
+func Open(data []byte, kind byte) (uint64, []byte, error) {
+	if len(data) < len(magic)+2+4 {
+		return 0, nil, errShortBuffer
+	}
+	body, sum := data[:len(data)-4], binary.LittleEndian.Uint32(data[len(data)-4:])
+	if crc32.ChecksumIEEE(body) != sum {
+		return 0, nil, fmt.Errorf("checksum mismatch")
+	}
+	if [3]byte(body[:3]) != magic {
+		return 0, nil, fmt.Errorf("invalid header")
+	}
+	if body[3] != Version {
+		return 0, nil, fmt.Errorf("unsupported version: %v", body[3])
+	}
+	if body[4] != kind {
+		return 0, nil, fmt.Errorf("unexpected collection kind: %q (want %q)", body[4], kind)
+	}
+	count, n, err := uvarint(body[5:])
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w", err)
+	}
+	return count, body[5+n:], nil
+}
@@ -0,0 +1,113 @@
+package codec
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+type point struct {
+	x, y byte
+}
+
+func (p point) MarshalBinary() ([]byte, error) {
+	return []byte{p.x, p.y}, nil
+}
+
+func (p *point) UnmarshalBinary(data []byte) error {
+	if len(data) != 2 {
+		return fmt.Errorf("invalid point")
+	}
+	p.x, p.y = data[0], data[1]
+	return nil
+}
+
+func roundTrip[T any](t *testing.T, values []T, equal func(a, b T) bool) {
+	t.Helper()
+	c := Default[T]()
+
+	var buf []byte
+	var err error
+	for _, value := range values {
+		buf, err = c.Append(buf, value)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	for _, want := range values {
+		got, n, err := c.Decode(buf)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !equal(got, want) {
+			t.Errorf("actual = %v, want %v", got, want)
+		}
+		buf = buf[n:]
+	}
+	if len(buf) != 0 {
+		t.Errorf("actual trailing bytes = %v, want 0", len(buf))
+	}
+}
+
+func TestDefaultCodec(t *testing.T) {
+	t.Run("Test round trip int", func(t *testing.T) {
+		roundTrip(t, []int{0, -1, 1, 1 << 40, -1 << 40}, func(a, b int) bool { return a == b })
+	})
+	t.Run("Test round trip uint8", func(t *testing.T) {
+		roundTrip(t, []uint8{0, 1, 255}, func(a, b uint8) bool { return a == b })
+	})
+	t.Run("Test round trip float64", func(t *testing.T) {
+		roundTrip(t, []float64{0, -1.5, 3.25e100}, func(a, b float64) bool { return a == b })
+	})
+	t.Run("Test round trip string", func(t *testing.T) {
+		roundTrip(t, []string{"", "a", "hello, world"}, func(a, b string) bool { return a == b })
+	})
+	t.Run("Test round trip bytes", func(t *testing.T) {
+		roundTrip(t, [][]byte{{}, {1, 2, 3}}, bytes.Equal)
+	})
+	t.Run("Test round trip bool", func(t *testing.T) {
+		roundTrip(t, []bool{true, false}, func(a, b bool) bool { return a == b })
+	})
+	t.Run("Test round trip binary marshaler", func(t *testing.T) {
+		roundTrip(t, []point{{1, 2}, {3, 4}}, func(a, b point) bool { return a == b })
+	})
+	t.Run("Test unsupported type", func(t *testing.T) {
+		if _, err := Default[struct{ a int }]().Append(nil, struct{ a int }{}); err == nil {
+			t.Errorf("expected error for unsupported type")
+		}
+		if _, _, err := Default[chan int]().Decode([]byte{0}); err == nil {
+			t.Errorf("expected error for unsupported type")
+		}
+	})
+	t.Run("Test truncated string", func(t *testing.T) {
+		if _, _, err := Default[string]().Decode([]byte{5, 'a'}); err == nil {
+			t.Errorf("expected error for truncated data")
+		}
+	})
+}
+
+func TestFrame(t *testing.T) {
+	frame := Seal(append(AppendHeader(nil, KindDeque, 2), 7, 8))
+
+	count, payload, err := Open(frame, KindDeque)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != 2 || !bytes.Equal(payload, []byte{7, 8}) {
+		t.Errorf("actual = %v %v, want %v %v", count, payload, 2, []byte{7, 8})
+	}
+
+	if _, _, err := Open(frame, KindAVL); err == nil {
+		t.Errorf("expected error for wrong kind")
+	}
+
+	corrupted := append([]byte(nil), frame...)
+	corrupted[len(corrupted)-5]++
+	if _, _, err := Open(corrupted, KindDeque); err == nil {
+		t.Errorf("expected error for checksum mismatch")
+	}
+
+	if _, _, err := Open(frame[:3], KindDeque); err == nil {
+		t.Errorf("expected error for truncated frame")
+	}
+}
@@ -0,0 +1,175 @@
+package codec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+)
+
+// Kinds of collection stored in a stream. Streams use their own kinds
+// because every entry is length-prefixed, unlike in a binary frame.
+const (
+	KindDequeStream byte = 'D'
+	KindAVLStream   byte = 'A'
+)
+
+// Writer writes a stream: the same header as a binary frame, followed
+// by length-prefixed records and the CRC-32 checksum of everything before
+// it. Records are written straight to the underlying writer, so no more
+// than one record is held in memory.
+type Writer struct {
+	w   io.Writer
+	crc hash.Hash32
+	n   int64
+	buf []byte
+}
+
+// NewWriter starts a stream of count records of the given kind.
+func NewWriter(w io.Writer, kind byte, count uint64) (*Writer, error) {
+	sw := &Writer{
+		w:   w,
+		crc: crc32.NewIEEE(),
+	}
+	if err := sw.write(AppendHeader(nil, kind, count)); err != nil {
+		return sw, fmt.Errorf("%w", err)
+	}
+	return sw, nil
+}
+
+// BytesWritten returns the number of bytes written so far.
+func (sw *Writer) BytesWritten() int64 {
+	return sw.n
+}
+
+// Close writes the checksum. It does not close the underlying writer.
+func (sw *Writer) Close() error {
+	return sw.write(binary.LittleEndian.AppendUint32(nil, sw.crc.Sum32()))
+}
+
+// WriteRecord writes the record built by calling encode with
+// an empty buffer. The buffer is reused between records.
+func (sw *Writer) WriteRecord(encode func(buf []byte) ([]byte, error)) error {
+	record, err := encode(sw.buf[:0])
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	sw.buf = record
+	if err := sw.write(binary.AppendUvarint(nil, uint64(len(record)))); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return sw.write(record)
+}
+
+func (sw *Writer) write(p []byte) error {
+	n, err := sw.w.Write(p)
+	sw.n += int64(n)
+	sw.crc.Write(p[:n])
+	return err
+}
+
+// Reader reads a stream written by Writer. It never reads past the
+// checksum, so the underlying reader can hold more data after the stream.
+type Reader struct {
+	r     io.Reader
+	crc   hash.Hash32
+	n     int64
+	count uint64
+	buf   []byte
+	one   [1]byte
+}
+
+// NewReader reads and checks the header of a stream of the given kind.
+func NewReader(r io.Reader, kind byte) (*Reader, error) {
+	sr := &Reader{
+		r:   r,
+		crc: crc32.NewIEEE(),
+	}
+
+	header := make([]byte, len(magic)+2)
+	if err := sr.read(header); err != nil {
+		return sr, fmt.Errorf("%w", err)
+	}
+	if [3]byte(header[:3]) != magic {
+		return sr, fmt.Errorf("invalid header")
+	}
+	if header[3] != Version {
+		return sr, fmt.Errorf("unsupported version: %v", header[3])
+	}
+	if header[4] != kind {
+		return sr, fmt.Errorf("unexpected collection kind: %q (want %q)", header[4], kind)
+	}
+
+	count, err := binary.ReadUvarint(sr)
+	if err != nil {
+		return sr, fmt.Errorf("%w", err)
+	}
+	sr.count = count
+	return sr, nil
+}
+
+// BytesRead returns the number of bytes read so far.
+func (sr *Reader) BytesRead() int64 {
+	return sr.n
+}
+
+// Close reads the checksum and compares it with the data read so far.
+// It does not close the underlying reader.
+func (sr *Reader) Close() error {
+	sum := sr.crc.Sum32()
+	trailer := make([]byte, 4)
+	if err := sr.read(trailer); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if binary.LittleEndian.Uint32(trailer) != sum {
+		return fmt.Errorf("checksum mismatch")
+	}
+	return nil
+}
+
+// Count returns the number of records announced by the header.
+func (sr *Reader) Count() uint64 {
+	return sr.count
+}
+
+// ReadByte implements io.ByteReader for binary.ReadUvarint.
+func (sr *Reader) ReadByte() (byte, error) {
+	if err := sr.read(sr.one[:]); err != nil {
+		return 0, err
+	}
+	return sr.one[0], nil
+}
+
+// ReadRecord reads the next record. The returned slice is only
+// valid until the next call to ReadRecord.
+func (sr *Reader) ReadRecord() ([]byte, error) {
+	length, err := binary.ReadUvarint(sr)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	// Grow the buffer in steps so a corrupted length fails
+	// on the missing data instead of on the allocation
+	sr.buf = sr.buf[:0]
+	for remaining := length; remaining > 0; {
+		step := min(remaining, 1<<16)
+		start := len(sr.buf)
+		sr.buf = append(sr.buf, make([]byte, step)...)
+		if err := sr.read(sr.buf[start:]); err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		remaining -= step
+	}
+	return sr.buf, nil
+}
+
+func (sr *Reader) read(p []byte) error {
+	n, err := io.ReadFull(sr.r, p)
+	sr.n += int64(n)
+	sr.crc.Write(p[:n])
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,81 @@
+package codec
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestStream(t *testing.T) {
+	var buf bytes.Buffer
+	sw, err := NewWriter(&buf, KindDequeStream, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, record := range [][]byte{{1, 2, 3}, {}} {
+		err := sw.WriteRecord(func(b []byte) ([]byte, error) {
+			return append(b, record...), nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := sw.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	written := sw.BytesWritten()
+
+	// Data after the stream must not be consumed
+	buf.WriteString("tail")
+
+	sr, err := NewReader(&buf, KindDequeStream)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sr.Count() != 2 {
+		t.Errorf("actual count = %v, want %v", sr.Count(), 2)
+	}
+	first, _ := sr.ReadRecord()
+	if !bytes.Equal(first, []byte{1, 2, 3}) {
+		t.Errorf("actual = %v, want %v", first, []byte{1, 2, 3})
+	}
+	second, _ := sr.ReadRecord()
+	if len(second) != 0 {
+		t.Errorf("actual = %v, want empty record", second)
+	}
+	if err := sr.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sr.BytesRead() != written {
+		t.Errorf("actual bytes read = %v, want %v", sr.BytesRead(), written)
+	}
+	if buf.String() != "tail" {
+		t.Errorf("actual remaining = %q, want %q", buf.String(), "tail")
+	}
+}
+
+func TestStreamInvalid(t *testing.T) {
+	var buf bytes.Buffer
+	sw, _ := NewWriter(&buf, KindAVLStream, 1)
+	_ = sw.WriteRecord(func(b []byte) ([]byte, error) {
+		return append(b, 9), nil
+	})
+	_ = sw.Close()
+	data := buf.Bytes()
+
+	if _, err := NewReader(bytes.NewReader(data), KindDequeStream); err == nil {
+		t.Errorf("expected error for wrong kind")
+	}
+
+	corrupted := append([]byte(nil), data...)
+	corrupted[len(corrupted)-5] = 8
+	sr, _ := NewReader(bytes.NewReader(corrupted), KindAVLStream)
+	_, _ = sr.ReadRecord()
+	if err := sr.Close(); err == nil {
+		t.Errorf("expected error for checksum mismatch")
+	}
+
+	sr, _ = NewReader(bytes.NewReader(data[:len(data)-6]), KindAVLStream)
+	if _, err := sr.ReadRecord(); err == nil {
+		t.Errorf("expected error for truncated record")
+	}
+}
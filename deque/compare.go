@@ -0,0 +1,52 @@
+package deque
+
+// Clone returns a copy of the list with new nodes and the same bound.
+// Every value is copied with cloneValue, or assigned if it is nil.
+func (list *DequeueList[T]) Clone(cloneValue func(T) T) *DequeueList[T] {
+	clone := &DequeueList[T]{
+		maxLength: list.maxLength,
+	}
+	for current := list.head; current != nil; current = current.next {
+		value := current.value
+		if cloneValue != nil {
+			value = cloneValue(value)
+		}
+		clone.PushRight(value)
+	}
+	return clone
+}
+
+// Compare compares the values of both lists from head to tail using cmp,
+// like slices.CompareFunc. The first non-zero result is returned, and if
+// one list is a prefix of the other, the shorter list is the smaller one.
+func (list *DequeueList[T]) Compare(other *DequeueList[T], cmp func(a, b T) int) int {
+	a, b := list.head, other.head
+	for a != nil && b != nil {
+		if c := cmp(a.value, b.value); c != 0 {
+			return c
+		}
+		a, b = a.next, b.next
+	}
+	switch {
+	case a != nil:
+		return 1
+	case b != nil:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// Equal reports whether both lists have the same length and eq
+// returns true for every pair of values at the same index.
+func (list *DequeueList[T]) Equal(other *DequeueList[T], eq func(a, b T) bool) bool {
+	if list.length != other.length {
+		return false
+	}
+	for a, b := list.head, other.head; a != nil; a, b = a.next, b.next {
+		if !eq(a.value, b.value) {
+			return false
+		}
+	}
+	return true
+}
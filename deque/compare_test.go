@@ -0,0 +1,95 @@
+package deque
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestClone(t *testing.T) {
+	list := NewBoundedDequeue([][]int{{1}, {2, 3}}, 4)
+
+	shallow := list.Clone(nil)
+	deep := list.Clone(slices.Clone[[]int])
+	if shallow.MaxLength() != 4 || deep.MaxLength() != 4 {
+		t.Errorf("actual bound = %v, %v, want %v", shallow.MaxLength(), deep.MaxLength(), 4)
+	}
+
+	// The clones have their own nodes
+	list.Front().Value()[0] = 10
+	list.PushRight([]int{4})
+	if shallow.Length() != 2 || deep.Length() != 2 {
+		t.Errorf("actual length = %v, %v, want length %v", shallow.Length(), deep.Length(), 2)
+	}
+	if got := shallow.Front().Value()[0]; got != 10 {
+		t.Errorf("shallow clone: actual = %v, want %v", got, 10)
+	}
+	if got := deep.Front().Value()[0]; got != 1 {
+		t.Errorf("deep clone: actual = %v, want %v", got, 1)
+	}
+	if err := shallow.MoveToFront(list.Front()); err == nil {
+		t.Errorf("expected error when moving an element of the original list")
+	}
+}
+
+type testCaseCompare[T any] struct {
+	name        string
+	input       []T
+	inputOther  []T
+	wantEqual   bool
+	wantCompare int
+}
+
+func TestEqualAndCompare(t *testing.T) {
+	tests := []testCaseCompare[int]{
+		{
+			name:        "Test compare equal lists",
+			input:       []int{1, 2, 3},
+			inputOther:  []int{1, 2, 3},
+			wantEqual:   true,
+			wantCompare: 0,
+		},
+		{
+			name:        "Test compare different value",
+			input:       []int{1, 2, 3},
+			inputOther:  []int{1, 4, 0},
+			wantEqual:   false,
+			wantCompare: -1,
+		},
+		{
+			name:        "Test compare longer list",
+			input:       []int{1, 2, 3},
+			inputOther:  []int{1, 2},
+			wantEqual:   false,
+			wantCompare: 1,
+		},
+		{
+			name:        "Test compare empty lists",
+			input:       []int{},
+			inputOther:  []int{},
+			wantEqual:   true,
+			wantCompare: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewDequeue(tt.input)
+			other := NewDequeue(tt.inputOther)
+			eq := func(a, b int) bool {
+				return a == b
+			}
+			if got := list.Equal(other, eq); got != tt.wantEqual {
+				t.Errorf("actual = %v, want %v", got, tt.wantEqual)
+			}
+			if got := list.Compare(other, cmp.Compare[int]); got != tt.wantCompare {
+				t.Errorf("actual = %v, want %v", got, tt.wantCompare)
+			}
+			if got := other.Compare(list, cmp.Compare[int]); got != -tt.wantCompare {
+				t.Errorf("actual reversed = %v, want %v", got, -tt.wantCompare)
+			}
+			if want := slices.Compare(tt.input, tt.inputOther); want != tt.wantCompare {
+				t.Errorf("test case disagrees with slices.Compare: %v", want)
+			}
+		})
+	}
+}
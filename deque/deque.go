@@ -1,40 +1,55 @@
 package deque
 
-import "fmt"
-
-// Node contains the value and the next and previous node.
-// The value can be anything, from int to string to struct or even map.
-type Node[T any] struct {
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Element is a node of the list, containing the value and the next and
+// previous node. The value can be anything, from int to string to struct
+// or even map. Elements returned by the list can be used as handles to
+// change the list around them in O(1), see element.go.
+type Element[T any] struct {
 	value T
-	prev  *Node[T]
-	next  *Node[T]
+	prev  *Element[T]
+	next  *Element[T]
+	owner *owner
 }
 
+// Node is the former name of Element.
+//
+// Deprecated: use Element.
+type Node[T any] = Element[T]
+
 // DequeueList contains the head and tail nodes.
 // The head points to the first node and the tail points to the last node.
 // The head and tail can be nil if the list is empty.
 // For the sake of consistency of definition of head/tail terms,
 // the head is always on the left side, while tail is always on the right side.
+// A non-zero maxLength bounds the list, see NewBoundedDequeue.
 type DequeueList[T any] struct {
-	head   *Node[T]
-	tail   *Node[T]
-	length uint
+	head      *Element[T]
+	tail      *Element[T]
+	length    uint
+	maxLength uint
+	owner     *owner
 }
 
+// At returns the value at index, counted from the head.
 func (list *DequeueList[T]) At(index uint) (T, error) {
-	current := list.head
-
-	var pos uint = 0
-
-	for current != nil {
-		if pos == index {
-			return current.value, nil
-		}
-		current = current.next
-		pos++
+	node, err := list.nodeAt(index)
+	if err != nil {
+		var empty T
+		return empty, fmt.Errorf("%w", err)
 	}
-	var empty T
-	return empty, fmt.Errorf("index out of range: %v (total length: %v)", index, list.length)
+	return node.value, nil
+}
+
+// Back returns the tail of the list, or nil if the list is empty.
+func (list *DequeueList[T]) Back() *Element[T] {
+	return list.tail
 }
 
 // Clear removes all nodes from the list
@@ -44,6 +59,8 @@ func (list *DequeueList[T]) Clear() {
 	for current != nil {
 		next := current.next
 		current.next = nil
+		current.prev = nil
+		current.owner = nil
 		current = next
 	}
 
@@ -52,6 +69,11 @@ func (list *DequeueList[T]) Clear() {
 	list.length = 0
 }
 
+// Front returns the head of the list, or nil if the list is empty.
+func (list *DequeueList[T]) Front() *Element[T] {
+	return list.head
+}
+
 func NewDequeue[T any](list []T) *DequeueList[T] {
 	deque := &DequeueList[T]{}
 	for _, value := range list {
@@ -60,12 +82,71 @@ func NewDequeue[T any](list []T) *DequeueList[T] {
 	return deque
 }
 
+// NewBoundedDequeue creates a list holding at most maxLength values,
+// like a Python deque with maxlen. Once the list is full, pushing to
+// one end evicts the value at the opposite end, so only the last
+// maxLength values of list are kept. A maxLength of 0 is unbounded.
+func NewBoundedDequeue[T any](list []T, maxLength uint) *DequeueList[T] {
+	deque := &DequeueList[T]{
+		maxLength: maxLength,
+	}
+	for _, value := range list {
+		deque.PushRight(value)
+	}
+	return deque
+}
+
+// InsertAt adds a new node at index, so the value can be found at
+// that index afterwards. An index equal to the length of the list
+// adds the node after the tail. Unlike the pushes, it never evicts,
+// so it fails on a full bounded list whatever the index.
+func (list *DequeueList[T]) InsertAt(index uint, value T) error {
+	// evicting either end would move the value away from index
+	if list.isFull() {
+		return fmt.Errorf("list is full")
+	}
+	if index == list.length {
+		list.PushRight(value)
+		return nil
+	}
+
+	// the new node is placed before the node currently at index
+	next, err := list.nodeAt(index)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if next.prev == nil {
+		list.PushLeft(value)
+		return nil
+	}
+
+	newNode := &Element[T]{
+		value: value,
+		prev:  next.prev,
+		next:  next,
+		owner: list.id(),
+	}
+	next.prev.next = newNode
+	next.prev = newNode
+
+	// update length
+	list.length++
+	return nil
+}
+
 // PushLeft adds a new node to the left of the list.
 // The new added node becomes the new head of the list.
-func (list *DequeueList[T]) PushLeft(value T) {
+// If the list is full, the tail is evicted first.
+// The returned element can be used as a handle to the new node.
+func (list *DequeueList[T]) PushLeft(value T) *Element[T] {
+	if list.isFull() {
+		_, _ = list.PopRight()
+	}
+
 	// create a new node
-	newNode := &Node[T]{
+	newNode := &Element[T]{
 		value: value,
+		owner: list.id(),
 	}
 
 	// If the list is empty (no tail),
@@ -92,14 +173,22 @@ func (list *DequeueList[T]) PushLeft(value T) {
 	// update length
 	list.length++
 
+	return newNode
 }
 
 // PushRight adds a new node to the right of the list.
 // The new added node becomes the new tail of the list.
-func (list *DequeueList[T]) PushRight(value T) {
+// If the list is full, the head is evicted first.
+// The returned element can be used as a handle to the new node.
+func (list *DequeueList[T]) PushRight(value T) *Element[T] {
+	if list.isFull() {
+		_, _ = list.PopLeft()
+	}
+
 	// create a new node
-	newNode := &Node[T]{
+	newNode := &Element[T]{
 		value: value,
+		owner: list.id(),
 	}
 
 	// If the list is empty (no head),
@@ -125,6 +214,26 @@ func (list *DequeueList[T]) PushRight(value T) {
 
 	// update length
 	list.length++
+
+	return newNode
+}
+
+// PeekLeft returns the value of the head without removing it.
+func (list *DequeueList[T]) PeekLeft() (T, error) {
+	if list.head == nil {
+		var empty T
+		return empty, fmt.Errorf("list is empty")
+	}
+	return list.head.value, nil
+}
+
+// PeekRight returns the value of the tail without removing it.
+func (list *DequeueList[T]) PeekRight() (T, error) {
+	if list.tail == nil {
+		var empty T
+		return empty, fmt.Errorf("list is empty")
+	}
+	return list.tail.value, nil
 }
 
 // PopLeft removes the head of the list and returns the value of the removed node.
@@ -144,10 +253,15 @@ func (list *DequeueList[T]) PopLeft() (T, error) {
 	// set the next node as the new head
 	list.head = next
 
-	// the new head will has no previous node, so we set it to nil
+	// the new head will has no previous node, so we set it to nil.
+	// If there is no new head, the list is empty and has no tail either
 	if next != nil {
 		list.head.prev = nil
+	} else {
+		list.tail = nil
 	}
+	popNode.next = nil
+	popNode.owner = nil
 
 	// update length
 	list.length--
@@ -170,10 +284,15 @@ func (list *DequeueList[T]) PopRight() (T, error) {
 	// set the previous node as the new tail
 	list.tail = prev
 
-	// the new tail will has no next node, so we set it to nil
+	// the new tail will has no next node, so we set it to nil.
+	// If there is no new tail, the list is empty and has no head either
 	if prev != nil {
 		list.tail.next = nil
+	} else {
+		list.head = nil
 	}
+	popNode.prev = nil
+	popNode.owner = nil
 
 	// update length
 	list.length--
@@ -181,30 +300,113 @@ func (list *DequeueList[T]) PopRight() (T, error) {
 	return popNode.value, nil
 }
 
+// RemoveAt removes the node at index and returns its value.
+func (list *DequeueList[T]) RemoveAt(index uint) (T, error) {
+	node, err := list.nodeAt(index)
+	if err != nil {
+		var empty T
+		return empty, fmt.Errorf("%w", err)
+	}
+
+	list.unlink(node)
+	return node.value, nil
+}
+
+// Reverse reverses the order of the nodes in place.
+func (list *DequeueList[T]) Reverse() {
+	current := list.head
+	for current != nil {
+		next := current.next
+		current.next, current.prev = current.prev, current.next
+		current = next
+	}
+	list.head, list.tail = list.tail, list.head
+}
+
+// Rotate moves n values from the tail to the head when n is positive,
+// or -n values from the head to the tail when n is negative, like
+// rotate of a Python deque. The nodes are relinked, not copied.
+func (list *DequeueList[T]) Rotate(n int) {
+	if list.length < 2 {
+		return
+	}
+	length := int(list.length)
+	steps := (n%length + length) % length
+	if steps == 0 {
+		return
+	}
+
+	// find the new head before closing the ring,
+	// as nodeAt needs both ends of the list
+	newHead, _ := list.nodeAt(uint(length - steps))
+	list.tail.next = list.head
+	list.head.prev = list.tail
+
+	// cut the ring in front of the new head
+	list.head = newHead
+	list.tail = newHead.prev
+	list.head.prev = nil
+	list.tail.next = nil
+}
+
+// Set replaces the value at index.
+func (list *DequeueList[T]) Set(index uint, value T) error {
+	node, err := list.nodeAt(index)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	node.value = value
+	return nil
+}
+
+// Swap exchanges the values at index i and j.
+func (list *DequeueList[T]) Swap(i, j uint) error {
+	first, err := list.nodeAt(i)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	second, err := list.nodeAt(j)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	first.value, second.value = second.value, first.value
+	return nil
+}
+
 // Debug prints the value of each node in the list.
 // It contains basic information about the list.
 func (list *DequeueList[T]) Debug() {
+	_ = list.DebugTo(os.Stdout)
+}
+
+// DebugTo writes the value of each node in the list to w,
+// in the same format as Debug.
+func (list *DequeueList[T]) DebugTo(w io.Writer) error {
+	var sb strings.Builder
 	current := list.head
 	count := 1
 	for current != nil {
-		fmt.Printf("No.: %v\n", count)
+		fmt.Fprintf(&sb, "No.: %v\n", count)
 		if current.prev == nil {
-			fmt.Print("nil <- ")
+			fmt.Fprint(&sb, "nil <- ")
 		} else {
-			fmt.Printf("%v <- ", current.prev.value)
+			fmt.Fprintf(&sb, "%v <- ", current.prev.value)
 		}
 
-		fmt.Printf("%v", current.value)
+		fmt.Fprintf(&sb, "%v", current.value)
 
 		if current.next == nil {
-			fmt.Print(" -> nil\n")
+			fmt.Fprint(&sb, " -> nil\n")
 		} else {
-			fmt.Printf(" -> %v\n\n", current.next.value)
+			fmt.Fprintf(&sb, " -> %v\n\n", current.next.value)
 		}
 
 		current = current.next
 		count++
 	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
 }
 
 func (list DequeueList[T]) IsEmpty() bool {
@@ -214,3 +416,56 @@ func (list DequeueList[T]) IsEmpty() bool {
 func (list DequeueList[T]) Length() uint {
 	return list.length
 }
+
+// MaxLength returns the bound of the list, or 0 if it is unbounded.
+func (list DequeueList[T]) MaxLength() uint {
+	return list.maxLength
+}
+
+func (list *DequeueList[T]) isFull() bool {
+	return list.maxLength > 0 && list.length >= list.maxLength
+}
+
+// nodeAt returns the node at index, walking from the head
+// or from the tail, whichever is closer.
+func (list *DequeueList[T]) nodeAt(index uint) (*Element[T], error) {
+	if index >= list.length {
+		return nil, fmt.Errorf("index out of range: %v (total length: %v)", index, list.length)
+	}
+
+	if index < list.length/2 {
+		current := list.head
+		for pos := uint(0); pos < index; pos++ {
+			current = current.next
+		}
+		return current, nil
+	}
+
+	current := list.tail
+	for pos := list.length - 1; pos > index; pos-- {
+		current = current.prev
+	}
+	return current, nil
+}
+
+// unlink removes the node from the list.
+func (list *DequeueList[T]) unlink(node *Element[T]) {
+	// the neighbours are linked to each other, or become
+	// the new head or tail when the node was at an end
+	if node.prev != nil {
+		node.prev.next = node.next
+	} else {
+		list.head = node.next
+	}
+	if node.next != nil {
+		node.next.prev = node.prev
+	} else {
+		list.tail = node.prev
+	}
+	node.prev = nil
+	node.next = nil
+	node.owner = nil
+
+	// update length
+	list.length--
+}
@@ -458,3 +458,356 @@ func TestAt(t *testing.T) {
 		})
 	}
 }
+
+type testCaseIndexMutation[T any] struct {
+	name         string
+	input        []T
+	inputFunc    func(list *DequeueList[T]) error
+	wantErr      error
+	wantDequeVal []T
+}
+
+func TestIndexMutation(t *testing.T) {
+	tests := []testCaseIndexMutation[string]{
+		{
+			name:  "Test insert at head",
+			input: []string{"10", "20"},
+			inputFunc: func(list *DequeueList[string]) error {
+				return list.InsertAt(0, "5")
+			},
+			wantDequeVal: []string{"5", "10", "20"},
+		},
+		{
+			name:  "Test insert at middle",
+			input: []string{"10", "20", "30", "40"},
+			inputFunc: func(list *DequeueList[string]) error {
+				return list.InsertAt(3, "35")
+			},
+			wantDequeVal: []string{"10", "20", "30", "35", "40"},
+		},
+		{
+			name:  "Test insert after tail",
+			input: []string{"10", "20"},
+			inputFunc: func(list *DequeueList[string]) error {
+				return list.InsertAt(2, "30")
+			},
+			wantDequeVal: []string{"10", "20", "30"},
+		},
+		{
+			name:  "Test insert out of range",
+			input: []string{"10", "20"},
+			inputFunc: func(list *DequeueList[string]) error {
+				return list.InsertAt(3, "30")
+			},
+			wantErr:      fmt.Errorf("index out of range: 3 (total length: 2)"),
+			wantDequeVal: []string{"10", "20"},
+		},
+		{
+			name:  "Test remove at middle",
+			input: []string{"10", "20", "30", "40", "50"},
+			inputFunc: func(list *DequeueList[string]) error {
+				value, err := list.RemoveAt(3)
+				if value != "40" {
+					return fmt.Errorf("removed %v", value)
+				}
+				return err
+			},
+			wantDequeVal: []string{"10", "20", "30", "50"},
+		},
+		{
+			name:  "Test remove the only node",
+			input: []string{"10"},
+			inputFunc: func(list *DequeueList[string]) error {
+				_, err := list.RemoveAt(0)
+				return err
+			},
+			wantDequeVal: []string{},
+		},
+		{
+			name:  "Test remove from empty list",
+			input: []string{},
+			inputFunc: func(list *DequeueList[string]) error {
+				_, err := list.RemoveAt(0)
+				return err
+			},
+			wantErr:      fmt.Errorf("index out of range: 0 (total length: 0)"),
+			wantDequeVal: []string{},
+		},
+		{
+			name:  "Test set at tail",
+			input: []string{"10", "20", "30"},
+			inputFunc: func(list *DequeueList[string]) error {
+				return list.Set(2, "33")
+			},
+			wantDequeVal: []string{"10", "20", "33"},
+		},
+		{
+			name:  "Test swap head and tail",
+			input: []string{"10", "20", "30"},
+			inputFunc: func(list *DequeueList[string]) error {
+				return list.Swap(0, 2)
+			},
+			wantDequeVal: []string{"30", "20", "10"},
+		},
+		{
+			name:  "Test swap out of range",
+			input: []string{"10", "20", "30"},
+			inputFunc: func(list *DequeueList[string]) error {
+				return list.Swap(0, 5)
+			},
+			wantErr:      fmt.Errorf("index out of range: 5 (total length: 3)"),
+			wantDequeVal: []string{"10", "20", "30"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewDequeue[string](tt.input)
+			err := tt.inputFunc(list)
+			if (err != nil) != (tt.wantErr != nil) {
+				t.Fatalf("actual = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErr.Error() {
+				t.Errorf("actual = %v, want %v", err, tt.wantErr)
+			}
+			if list.Length() != uint(len(tt.wantDequeVal)) {
+				t.Fatalf("actual length = %v, want length %v", list.Length(), len(tt.wantDequeVal))
+			}
+
+			// walk both directions to check the prev links as well
+			current := list.head
+			for i := 0; current != nil; i++ {
+				if current.value != tt.wantDequeVal[i] {
+					t.Errorf("actual = %v, want %v", current.value, tt.wantDequeVal[i])
+				}
+				current = current.next
+			}
+			current = list.tail
+			for i := len(tt.wantDequeVal) - 1; current != nil; i-- {
+				if current.value != tt.wantDequeVal[i] {
+					t.Errorf("actual = %v, want %v", current.value, tt.wantDequeVal[i])
+				}
+				current = current.prev
+			}
+		})
+	}
+}
+
+type testCasePeek[T any] struct {
+	name          string
+	input         []T
+	wantLeftVal   T
+	wantRightVal  T
+	wantErr       error
+	wantLengthVal uint
+}
+
+func TestPeek(t *testing.T) {
+	tests := []testCasePeek[string]{
+		{
+			name:          "Test peek both ends",
+			input:         []string{"10", "20", "30"},
+			wantLeftVal:   "10",
+			wantRightVal:  "30",
+			wantLengthVal: 3,
+		},
+		{
+			name:          "Test peek single node",
+			input:         []string{"10"},
+			wantLeftVal:   "10",
+			wantRightVal:  "10",
+			wantLengthVal: 1,
+		},
+		{
+			name:    "Test peek empty list",
+			input:   []string{},
+			wantErr: fmt.Errorf("list is empty"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewDequeue[string](tt.input)
+			left, err := list.PeekLeft()
+			if left != tt.wantLeftVal {
+				t.Errorf("actual = %v, want %v", left, tt.wantLeftVal)
+			}
+			if err != nil && err.Error() != tt.wantErr.Error() {
+				t.Errorf("actual = %v, want %v", err, tt.wantErr)
+			}
+			right, err := list.PeekRight()
+			if right != tt.wantRightVal {
+				t.Errorf("actual = %v, want %v", right, tt.wantRightVal)
+			}
+			if err != nil && err.Error() != tt.wantErr.Error() {
+				t.Errorf("actual = %v, want %v", err, tt.wantErr)
+			}
+			if list.Length() != tt.wantLengthVal {
+				t.Errorf("actual = %v, want %v", list.Length(), tt.wantLengthVal)
+			}
+		})
+	}
+}
+
+type testCaseRotate[T any] struct {
+	name         string
+	input        []T
+	inputRotate  int
+	wantDequeVal []T
+}
+
+func TestRotate(t *testing.T) {
+	tests := []testCaseRotate[int]{
+		{
+			name:         "Test rotate right",
+			input:        []int{1, 2, 3, 4, 5},
+			inputRotate:  2,
+			wantDequeVal: []int{4, 5, 1, 2, 3},
+		},
+		{
+			name:         "Test rotate left",
+			input:        []int{1, 2, 3, 4, 5},
+			inputRotate:  -2,
+			wantDequeVal: []int{3, 4, 5, 1, 2},
+		},
+		{
+			name:         "Test rotate more than the length",
+			input:        []int{1, 2, 3},
+			inputRotate:  7,
+			wantDequeVal: []int{3, 1, 2},
+		},
+		{
+			name:         "Test rotate by the length",
+			input:        []int{1, 2, 3},
+			inputRotate:  -3,
+			wantDequeVal: []int{1, 2, 3},
+		},
+		{
+			name:         "Test rotate empty list",
+			input:        []int{},
+			inputRotate:  1,
+			wantDequeVal: []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewDequeue(tt.input)
+			list.Rotate(tt.inputRotate)
+			checkListModel(t, list, tt.wantDequeVal)
+		})
+	}
+}
+
+func TestReverse(t *testing.T) {
+	list := NewDequeue([]int{1, 2, 3, 4})
+	list.Reverse()
+	checkListModel(t, list, []int{4, 3, 2, 1})
+
+	list = NewDequeue([]int{})
+	list.Reverse()
+	checkListModel(t, list, []int{})
+}
+
+type testCaseBounded[T any] struct {
+	name         string
+	input        []T
+	maxLength    uint
+	inputFunc    func(list *DequeueList[T]) error
+	wantErr      error
+	wantDequeVal []T
+}
+
+func TestBoundedDequeue(t *testing.T) {
+	tests := []testCaseBounded[int]{
+		{
+			name:         "Test bounded list keeps the last values",
+			input:        []int{1, 2, 3, 4, 5},
+			maxLength:    3,
+			inputFunc:    func(list *DequeueList[int]) error { return nil },
+			wantDequeVal: []int{3, 4, 5},
+		},
+		{
+			name:      "Test push right evicts the head",
+			input:     []int{1, 2, 3},
+			maxLength: 3,
+			inputFunc: func(list *DequeueList[int]) error {
+				list.PushRight(4)
+				return nil
+			},
+			wantDequeVal: []int{2, 3, 4},
+		},
+		{
+			name:      "Test push left evicts the tail",
+			input:     []int{1, 2, 3},
+			maxLength: 3,
+			inputFunc: func(list *DequeueList[int]) error {
+				list.PushLeft(0)
+				return nil
+			},
+			wantDequeVal: []int{0, 1, 2},
+		},
+		{
+			name:      "Test insert in the middle of a full list",
+			input:     []int{1, 2, 3},
+			maxLength: 3,
+			inputFunc: func(list *DequeueList[int]) error {
+				return list.InsertAt(1, 9)
+			},
+			wantErr:      fmt.Errorf("list is full"),
+			wantDequeVal: []int{1, 2, 3},
+		},
+		{
+			name:      "Test insert at head of a full list",
+			input:     []int{1, 2, 3},
+			maxLength: 3,
+			inputFunc: func(list *DequeueList[int]) error {
+				return list.InsertAt(0, 9)
+			},
+			wantErr:      fmt.Errorf("list is full"),
+			wantDequeVal: []int{1, 2, 3},
+		},
+		{
+			name:      "Test insert after tail of a full list",
+			input:     []int{1, 2, 3},
+			maxLength: 3,
+			inputFunc: func(list *DequeueList[int]) error {
+				return list.InsertAt(3, 9)
+			},
+			wantErr:      fmt.Errorf("list is full"),
+			wantDequeVal: []int{1, 2, 3},
+		},
+		{
+			name:      "Test insert after tail of a bounded list with room",
+			input:     []int{1, 2},
+			maxLength: 3,
+			inputFunc: func(list *DequeueList[int]) error {
+				return list.InsertAt(2, 9)
+			},
+			wantDequeVal: []int{1, 2, 9},
+		},
+		{
+			name:      "Test zero max length is unbounded",
+			input:     []int{1, 2, 3},
+			maxLength: 0,
+			inputFunc: func(list *DequeueList[int]) error {
+				list.PushRight(4)
+				return nil
+			},
+			wantDequeVal: []int{1, 2, 3, 4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewBoundedDequeue(tt.input, tt.maxLength)
+			err := tt.inputFunc(list)
+			if (err != nil) != (tt.wantErr != nil) {
+				t.Fatalf("actual = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErr.Error() {
+				t.Errorf("actual = %v, want %v", err, tt.wantErr)
+			}
+			if list.MaxLength() != tt.maxLength {
+				t.Errorf("actual = %v, want %v", list.MaxLength(), tt.maxLength)
+			}
+			checkListModel(t, list, tt.wantDequeVal)
+		})
+	}
+}
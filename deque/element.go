@@ -0,0 +1,173 @@
+package deque
+
+import (
+	"fmt"
+)
+
+// owner identifies the list an element belongs to. Concat moves the
+// elements of another list without touching them by forwarding the
+// owner of that list to the owner of the receiver.
+type owner struct {
+	forward *owner
+}
+
+// resolve follows the forwarding to the current owner, pointing
+// every owner on the way directly at it.
+func (o *owner) resolve() *owner {
+	root := o
+	for root.forward != nil {
+		root = root.forward
+	}
+	for o != root {
+		next := o.forward
+		o.forward = root
+		o = next
+	}
+	return root
+}
+
+// Next returns the element on the right side, or nil at the tail
+// or if the element was removed.
+func (element *Element[T]) Next() *Element[T] {
+	return element.next
+}
+
+// Prev returns the element on the left side, or nil at the head
+// or if the element was removed.
+func (element *Element[T]) Prev() *Element[T] {
+	return element.prev
+}
+
+// SetValue replaces the value of the element.
+func (element *Element[T]) SetValue(value T) {
+	element.value = value
+}
+
+func (element *Element[T]) Value() T {
+	return element.value
+}
+
+// InsertAfter adds a new element with the value right after mark,
+// which must belong to the list. A full bounded list rejects the insert.
+func (list *DequeueList[T]) InsertAfter(value T, mark *Element[T]) (*Element[T], error) {
+	if !list.owns(mark) {
+		return nil, fmt.Errorf("element does not belong to this list")
+	}
+	if list.isFull() {
+		return nil, fmt.Errorf("list is full")
+	}
+
+	element := &Element[T]{
+		value: value,
+		owner: list.id(),
+	}
+	list.linkAfter(element, mark)
+	return element, nil
+}
+
+// InsertBefore adds a new element with the value right before mark,
+// which must belong to the list. A full bounded list rejects the insert.
+func (list *DequeueList[T]) InsertBefore(value T, mark *Element[T]) (*Element[T], error) {
+	if !list.owns(mark) {
+		return nil, fmt.Errorf("element does not belong to this list")
+	}
+	if list.isFull() {
+		return nil, fmt.Errorf("list is full")
+	}
+
+	element := &Element[T]{
+		value: value,
+		owner: list.id(),
+	}
+	list.linkBefore(element, mark)
+	return element, nil
+}
+
+// MoveAfter moves the element right after mark. Both must belong
+// to the list, and moving an element after itself does nothing.
+func (list *DequeueList[T]) MoveAfter(element, mark *Element[T]) error {
+	if !list.owns(element) || !list.owns(mark) {
+		return fmt.Errorf("element does not belong to this list")
+	}
+	if element == mark {
+		return nil
+	}
+	list.unlink(element)
+	element.owner = list.id()
+	list.linkAfter(element, mark)
+	return nil
+}
+
+// MoveBefore moves the element right before mark. Both must belong
+// to the list, and moving an element before itself does nothing.
+func (list *DequeueList[T]) MoveBefore(element, mark *Element[T]) error {
+	if !list.owns(element) || !list.owns(mark) {
+		return fmt.Errorf("element does not belong to this list")
+	}
+	if element == mark {
+		return nil
+	}
+	list.unlink(element)
+	element.owner = list.id()
+	list.linkBefore(element, mark)
+	return nil
+}
+
+// Remove removes the element, which must belong to the list,
+// and returns its value.
+func (list *DequeueList[T]) Remove(element *Element[T]) (T, error) {
+	if !list.owns(element) {
+		var empty T
+		return empty, fmt.Errorf("element does not belong to this list")
+	}
+	list.unlink(element)
+	return element.value, nil
+}
+
+// id returns the owner of the list, creating it on first use
+// so the zero value of DequeueList is ready to use.
+func (list *DequeueList[T]) id() *owner {
+	if list.owner == nil {
+		list.owner = &owner{}
+	}
+	return list.owner
+}
+
+// linkAfter links the element, which is in no list, after mark.
+func (list *DequeueList[T]) linkAfter(element, mark *Element[T]) {
+	element.prev = mark
+	element.next = mark.next
+	if mark.next != nil {
+		mark.next.prev = element
+	} else {
+		list.tail = element
+	}
+	mark.next = element
+
+	// update length
+	list.length++
+}
+
+// linkBefore links the element, which is in no list, before mark.
+func (list *DequeueList[T]) linkBefore(element, mark *Element[T]) {
+	element.next = mark
+	element.prev = mark.prev
+	if mark.prev != nil {
+		mark.prev.next = element
+	} else {
+		list.head = element
+	}
+	mark.prev = element
+
+	// update length
+	list.length++
+}
+
+// owns reports whether the element belongs to the list.
+func (list *DequeueList[T]) owns(element *Element[T]) bool {
+	if element == nil || element.owner == nil || list.owner == nil {
+		return false
+	}
+	element.owner = element.owner.resolve()
+	return element.owner == list.owner
+}
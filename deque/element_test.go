@@ -0,0 +1,194 @@
+package deque
+
+import (
+	"testing"
+)
+
+func TestElementHandles(t *testing.T) {
+	list := &DequeueList[int]{}
+	two := list.PushRight(2)
+	one := list.PushLeft(1)
+	four := list.PushRight(4)
+
+	three, err := list.InsertAfter(3, two)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	zero, err := list.InsertBefore(0, one)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	five, err := list.InsertAfter(5, four)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkListModel(t, list, []int{0, 1, 2, 3, 4, 5})
+
+	if zero.Prev() != nil || zero.Next() != one || five.Next() != nil || three.Prev() != two {
+		t.Errorf("unexpected links between elements")
+	}
+
+	three.SetValue(33)
+	if three.Value() != 33 {
+		t.Errorf("actual = %v, want %v", three.Value(), 33)
+	}
+	three.SetValue(3)
+
+	value, err := list.Remove(three)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value != 3 {
+		t.Errorf("actual = %v, want %v", value, 3)
+	}
+	if three.Next() != nil || three.Prev() != nil {
+		t.Errorf("removed element is still linked")
+	}
+	checkListModel(t, list, []int{0, 1, 2, 4, 5})
+
+	// Removing twice is rejected
+	if _, err := list.Remove(three); err == nil {
+		t.Errorf("expected error when removing a removed element")
+	}
+}
+
+type testCaseMove[T any] struct {
+	name         string
+	input        []T
+	inputFunc    func(list *DequeueList[T], elements []*Element[T]) error
+	wantDequeVal []T
+}
+
+func TestMoveBeforeAndAfter(t *testing.T) {
+	tests := []testCaseMove[int]{
+		{
+			name:  "Test move before the head",
+			input: []int{1, 2, 3, 4},
+			inputFunc: func(list *DequeueList[int], elements []*Element[int]) error {
+				return list.MoveBefore(elements[2], elements[0])
+			},
+			wantDequeVal: []int{3, 1, 2, 4},
+		},
+		{
+			name:  "Test move after the tail",
+			input: []int{1, 2, 3, 4},
+			inputFunc: func(list *DequeueList[int], elements []*Element[int]) error {
+				return list.MoveAfter(elements[0], elements[3])
+			},
+			wantDequeVal: []int{2, 3, 4, 1},
+		},
+		{
+			name:  "Test move after the neighbour",
+			input: []int{1, 2, 3, 4},
+			inputFunc: func(list *DequeueList[int], elements []*Element[int]) error {
+				return list.MoveAfter(elements[1], elements[2])
+			},
+			wantDequeVal: []int{1, 3, 2, 4},
+		},
+		{
+			name:  "Test move before itself",
+			input: []int{1, 2, 3},
+			inputFunc: func(list *DequeueList[int], elements []*Element[int]) error {
+				return list.MoveBefore(elements[1], elements[1])
+			},
+			wantDequeVal: []int{1, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := &DequeueList[int]{}
+			elements := []*Element[int]{}
+			for _, value := range tt.input {
+				elements = append(elements, list.PushRight(value))
+			}
+			if err := tt.inputFunc(list, elements); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			checkListModel(t, list, tt.wantDequeVal)
+		})
+	}
+}
+
+func TestElementFromAnotherList(t *testing.T) {
+	list := NewDequeue([]int{1, 2})
+	other := NewDequeue([]int{3, 4})
+	foreign := other.Front()
+
+	if _, err := list.Remove(foreign); err == nil {
+		t.Errorf("expected error when removing an element of another list")
+	}
+	if _, err := list.InsertBefore(0, foreign); err == nil {
+		t.Errorf("expected error when inserting before an element of another list")
+	}
+	if err := list.MoveAfter(list.Front(), foreign); err == nil {
+		t.Errorf("expected error when moving after an element of another list")
+	}
+	if err := list.MoveToFront(foreign); err == nil {
+		t.Errorf("expected error when moving an element of another list")
+	}
+	checkListModel(t, list, []int{1, 2})
+	checkListModel(t, other, []int{3, 4})
+}
+
+func TestElementOwnerAfterConcatAndSplit(t *testing.T) {
+	list := NewDequeue([]int{1, 2})
+	other := NewDequeue([]int{3, 4, 5, 6})
+	three := other.Front()
+
+	// The elements of other move with its nodes
+	list.Concat(other)
+	if _, err := other.Remove(three); err == nil {
+		t.Errorf("expected error when removing an element through its old list")
+	}
+	if err := list.MoveToFront(three); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkListModel(t, list, []int{3, 1, 2, 4, 5, 6})
+
+	// Elements added to other after the concat belong to other only
+	seven := other.PushRight(7)
+	if _, err := list.Remove(seven); err == nil {
+		t.Errorf("expected error when removing an element of another list")
+	}
+
+	// Split with the longer side first, then with the shorter side first
+	rest, err := list.SplitAt(4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkListModel(t, list, []int{3, 1, 2, 4})
+	checkListModel(t, rest, []int{5, 6})
+	if err := list.MoveToBack(three); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rest, err = list.SplitAt(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkListModel(t, list, []int{1})
+	checkListModel(t, rest, []int{2, 4, 3})
+	if err := list.MoveToBack(three); err == nil {
+		t.Errorf("expected error when moving an element of the split off list")
+	}
+	if err := rest.MoveToFront(three); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkListModel(t, rest, []int{3, 2, 4})
+}
+
+func TestInsertIntoFullList(t *testing.T) {
+	list := NewBoundedDequeue([]int{1, 2}, 2)
+	if _, err := list.InsertAfter(3, list.Front()); err == nil {
+		t.Errorf("expected error when inserting into a full list")
+	}
+	checkListModel(t, list, []int{1, 2})
+}
+
+func TestNodeAlias(t *testing.T) {
+	list := NewDequeue([]int{1, 2})
+	var node *Node[int] = list.Front()
+	if node.Value() != 1 || node.Next().Value() != 2 {
+		t.Errorf("actual = %v, %v, want %v, %v", node.Value(), node.Next().Value(), 1, 2)
+	}
+}
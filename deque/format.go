@@ -0,0 +1,80 @@
+package deque
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// maxLogValues is the number of values included by LogValue.
+// Larger lists are truncated and marked as such.
+const maxLogValues = 32
+
+// Format implements fmt.Formatter. The list is printed like a slice,
+// from head to tail, with every value formatted using the same verb
+// and flags, e.g. [1 2 3] for %v or [0x1 0x2 0x3] for %#x.
+func (list *DequeueList[T]) Format(f fmt.State, verb rune) {
+	if list == nil {
+		fmt.Fprint(f, "<nil>")
+		return
+	}
+
+	format := fmt.FormatString(f, verb)
+	fmt.Fprint(f, "[")
+	for current := list.head; current != nil; current = current.next {
+		if current != list.head {
+			fmt.Fprint(f, " ")
+		}
+		fmt.Fprintf(f, format, current.value)
+	}
+	fmt.Fprint(f, "]")
+}
+
+// LogValue implements slog.LogValuer. It logs the length of the list
+// and at most 32 values from the head.
+func (list *DequeueList[T]) LogValue() slog.Value {
+	return list.logValues(maxLogValues)
+}
+
+// LogValueN returns a slog.LogValuer like LogValue that logs at most
+// n values. The values are only collected when the record is handled.
+func (list *DequeueList[T]) LogValueN(n int) slog.LogValuer {
+	return logValuer(func() slog.Value {
+		return list.logValues(n)
+	})
+}
+
+// String returns the list formatted with %v.
+func (list *DequeueList[T]) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%v", list)
+	return sb.String()
+}
+
+// logValuer adapts a function to slog.LogValuer.
+type logValuer func() slog.Value
+
+func (fn logValuer) LogValue() slog.Value {
+	return fn()
+}
+
+// logValues logs the length of the list and at most limit values.
+func (list *DequeueList[T]) logValues(limit int) slog.Value {
+	if list == nil {
+		return slog.GroupValue(slog.Uint64("length", 0))
+	}
+
+	values := make([]T, 0, min(list.length, uint(max(limit, 0))))
+	for current := list.head; current != nil && len(values) < limit; current = current.next {
+		values = append(values, current.value)
+	}
+
+	attrs := []slog.Attr{
+		slog.Uint64("length", uint64(list.length)),
+		slog.Any("values", values),
+	}
+	if uint(len(values)) < list.length {
+		attrs = append(attrs, slog.Bool("truncated", true))
+	}
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,88 @@
+package deque
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestDebugTo(t *testing.T) {
+	var sb strings.Builder
+	if err := NewDequeue([]int{1, 2}).DebugTo(&sb); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "No.: 1\nnil <- 1 -> 2\n\nNo.: 2\n1 <- 2 -> nil\n"
+	if sb.String() != want {
+		t.Errorf("actual = %q, want %q", sb.String(), want)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		input  []int
+		want   string
+	}{
+		{
+			name:   "Test format with %v",
+			format: "%v",
+			input:  []int{1, 2, 3},
+			want:   "[1 2 3]",
+		},
+		{
+			name:   "Test format with flags",
+			format: "%#x",
+			input:  []int{10, 255},
+			want:   "[0xa 0xff]",
+		},
+		{
+			name:   "Test format with width",
+			format: "%03d",
+			input:  []int{7},
+			want:   "[007]",
+		},
+		{
+			name:   "Test format empty list",
+			format: "%v",
+			input:  []int{},
+			want:   "[]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fmt.Sprintf(tt.format, NewDequeue(tt.input))
+			if got != tt.want {
+				t.Errorf("actual = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := NewDequeue([]string{"a", "b"}).String(); got != "[a b]" {
+		t.Errorf("actual = %v, want %v", got, "[a b]")
+	}
+}
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	logger.Info("queue", "list", NewDequeue([]int{1, 2, 3}).LogValueN(2))
+	logger.Info("queue", "list", NewDequeue([]int{1}))
+
+	want := "" +
+		"level=INFO msg=queue list.length=3 list.values=\"[1 2]\" list.truncated=true\n" +
+		"level=INFO msg=queue list.length=1 list.values=[1]\n"
+	if buf.String() != want {
+		t.Errorf("actual = %q, want %q", buf.String(), want)
+	}
+}
@@ -0,0 +1,92 @@
+package deque
+
+// All reports whether fn returns true for every value of the list.
+// It is true for an empty list.
+func All[T any](list *DequeueList[T], fn func(T) bool) bool {
+	for current := list.head; current != nil; current = current.next {
+		if !fn(current.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Any reports whether fn returns true for at least one value of the list.
+func Any[T any](list *DequeueList[T], fn func(T) bool) bool {
+	_, found := IndexFunc(list, fn)
+	return found
+}
+
+// ContainsFunc is Any, named after slices.ContainsFunc.
+func ContainsFunc[T any](list *DequeueList[T], fn func(T) bool) bool {
+	return Any(list, fn)
+}
+
+// Filter returns a new list with the values for which keep returns true,
+// in the same order.
+func Filter[T any](list *DequeueList[T], keep func(T) bool) *DequeueList[T] {
+	filtered := &DequeueList[T]{}
+	for current := list.head; current != nil; current = current.next {
+		if keep(current.value) {
+			filtered.PushRight(current.value)
+		}
+	}
+	return filtered
+}
+
+// IndexFunc returns the index of the first value, counted from the head,
+// for which fn returns true.
+func IndexFunc[T any](list *DequeueList[T], fn func(T) bool) (uint, bool) {
+	var index uint
+	for current := list.head; current != nil; current = current.next {
+		if fn(current.value) {
+			return index, true
+		}
+		index++
+	}
+	return 0, false
+}
+
+// Map returns a new list with fn applied to every value, in the same order.
+func Map[T, U any](list *DequeueList[T], fn func(T) U) *DequeueList[U] {
+	mapped := &DequeueList[U]{}
+	for current := list.head; current != nil; current = current.next {
+		mapped.PushRight(fn(current.value))
+	}
+	return mapped
+}
+
+// Reduce folds the values from head to tail into an accumulator,
+// starting with initial.
+func Reduce[T, A any](list *DequeueList[T], initial A, fn func(A, T) A) A {
+	accumulator := initial
+	for current := list.head; current != nil; current = current.next {
+		accumulator = fn(accumulator, current.value)
+	}
+	return accumulator
+}
+
+// RemoveIf removes, in place, the values for which fn returns true
+// and returns how many were removed.
+func RemoveIf[T any](list *DequeueList[T], fn func(T) bool) uint {
+	var removed uint
+	current := list.head
+	for current != nil {
+		next := current.next
+		if fn(current.value) {
+			list.unlink(current)
+			removed++
+		}
+		current = next
+	}
+	return removed
+}
+
+// ToSlice returns the values from head to tail.
+func ToSlice[T any](list *DequeueList[T]) []T {
+	values := make([]T, 0, list.length)
+	for current := list.head; current != nil; current = current.next {
+		values = append(values, current.value)
+	}
+	return values
+}
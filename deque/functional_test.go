@@ -0,0 +1,116 @@
+package deque
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func isEven(value int) bool {
+	return value%2 == 0
+}
+
+type testCaseFunctional[T any] struct {
+	name         string
+	input        []T
+	wantAll      bool
+	wantAny      bool
+	wantIndex    uint
+	wantFound    bool
+	wantFiltered []T
+	wantSum      T
+}
+
+func TestFunctional(t *testing.T) {
+	tests := []testCaseFunctional[int]{
+		{
+			name:         "Test functional helpers with mixed values",
+			input:        []int{1, 3, 4, 5, 6},
+			wantAll:      false,
+			wantAny:      true,
+			wantIndex:    2,
+			wantFound:    true,
+			wantFiltered: []int{4, 6},
+			wantSum:      19,
+		},
+		{
+			name:         "Test functional helpers without even values",
+			input:        []int{1, 3},
+			wantAll:      false,
+			wantAny:      false,
+			wantFound:    false,
+			wantFiltered: []int{},
+			wantSum:      4,
+		},
+		{
+			name:         "Test functional helpers with empty list",
+			input:        []int{},
+			wantAll:      true,
+			wantAny:      false,
+			wantFound:    false,
+			wantFiltered: []int{},
+			wantSum:      0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewDequeue(tt.input)
+			if got := All(list, isEven); got != tt.wantAll {
+				t.Errorf("All: actual = %v, want %v", got, tt.wantAll)
+			}
+			if got := Any(list, isEven); got != tt.wantAny {
+				t.Errorf("Any: actual = %v, want %v", got, tt.wantAny)
+			}
+			if got := ContainsFunc(list, isEven); got != tt.wantAny {
+				t.Errorf("ContainsFunc: actual = %v, want %v", got, tt.wantAny)
+			}
+			index, found := IndexFunc(list, isEven)
+			if index != tt.wantIndex || found != tt.wantFound {
+				t.Errorf("IndexFunc: actual = %v, %v, want %v, %v", index, found, tt.wantIndex, tt.wantFound)
+			}
+			checkListModel(t, Filter(list, isEven), tt.wantFiltered)
+			sum := Reduce(list, 0, func(sum, value int) int {
+				return sum + value
+			})
+			if sum != tt.wantSum {
+				t.Errorf("Reduce: actual = %v, want %v", sum, tt.wantSum)
+			}
+
+			// The helpers leave the list as it is
+			checkListModel(t, list, tt.input)
+		})
+	}
+}
+
+func TestMap(t *testing.T) {
+	list := NewDequeue([]int{1, 2, 3})
+	mapped := Map(list, strconv.Itoa)
+	if got := ToSlice(mapped); !slices.Equal(got, []string{"1", "2", "3"}) {
+		t.Errorf("actual = %v, want %v", got, []string{"1", "2", "3"})
+	}
+	if mapped.Length() != 3 {
+		t.Errorf("actual length = %v, want length %v", mapped.Length(), 3)
+	}
+}
+
+func TestRemoveIf(t *testing.T) {
+	list := NewDequeue([]int{2, 1, 4, 3, 6})
+	if removed := RemoveIf(list, isEven); removed != 3 {
+		t.Errorf("actual = %v, want %v", removed, 3)
+	}
+	checkListModel(t, list, []int{1, 3})
+
+	if removed := RemoveIf(list, func(int) bool { return true }); removed != 2 {
+		t.Errorf("actual = %v, want %v", removed, 2)
+	}
+	checkListModel(t, list, []int{})
+}
+
+func TestToSlice(t *testing.T) {
+	if got := ToSlice(NewDequeue([]int{})); got == nil || len(got) != 0 {
+		t.Errorf("actual = %#v, want an empty slice", got)
+	}
+	if got := ToSlice(NewDequeue([]int{3, 1, 2})); !slices.Equal(got, []int{3, 1, 2}) {
+		t.Errorf("actual = %v, want %v", got, []int{3, 1, 2})
+	}
+}
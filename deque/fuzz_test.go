@@ -0,0 +1,135 @@
+package deque
+
+import (
+	"slices"
+	"testing"
+)
+
+// checkListModel walks the list in both directions and
+// compares it with a reference slice.
+func checkListModel(t *testing.T, list *DequeueList[int], model []int) {
+	t.Helper()
+	if list.Length() != uint(len(model)) {
+		t.Fatalf("actual length = %v, want length %v", list.Length(), len(model))
+	}
+	if list.IsEmpty() != (len(model) == 0) {
+		t.Fatalf("actual empty = %v, want %v", list.IsEmpty(), len(model) == 0)
+	}
+
+	forward := []int{}
+	for current := list.head; current != nil && len(forward) <= len(model); current = current.next {
+		if !list.owns(current) {
+			t.Fatalf("element %v at index %v does not belong to the list", current.value, len(forward))
+		}
+		forward = append(forward, current.value)
+	}
+	if !slices.Equal(forward, model) {
+		t.Fatalf("actual head to tail = %v, want %v", forward, model)
+	}
+
+	backward := []int{}
+	for current := list.tail; current != nil && len(backward) <= len(model); current = current.prev {
+		backward = append(backward, current.value)
+	}
+	slices.Reverse(backward)
+	if !slices.Equal(backward, model) {
+		t.Fatalf("actual tail to head = %v, want %v", backward, model)
+	}
+}
+
+func FuzzDequeueList(f *testing.F) {
+	f.Add([]byte{0, 1, 1, 2, 2, 0, 3, 0})
+	f.Add([]byte{1, 1, 1, 2, 4, 1, 3, 0, 2, 0, 5, 0, 0, 7})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		list := NewDequeue([]int{})
+		model := []int{}
+
+		for i := 0; i+1 < len(data); i += 2 {
+			op, value := data[i]%11, int(data[i+1])
+			switch op {
+			case 0:
+				list.PushLeft(value)
+				model = slices.Insert(model, 0, value)
+			case 1:
+				list.PushRight(value)
+				model = append(model, value)
+			case 2:
+				got, err := list.PopLeft()
+				if (err != nil) != (len(model) == 0) {
+					t.Fatalf("PopLeft() error = %v, model length %v", err, len(model))
+				}
+				if len(model) > 0 {
+					if got != model[0] {
+						t.Fatalf("PopLeft() = %v, want %v", got, model[0])
+					}
+					model = model[1:]
+				}
+			case 3:
+				got, err := list.PopRight()
+				if (err != nil) != (len(model) == 0) {
+					t.Fatalf("PopRight() error = %v, model length %v", err, len(model))
+				}
+				if len(model) > 0 {
+					if got != model[len(model)-1] {
+						t.Fatalf("PopRight() = %v, want %v", got, model[len(model)-1])
+					}
+					model = model[:len(model)-1]
+				}
+			case 4:
+				index := uint(value % 8)
+				got, err := list.At(index)
+				if (err != nil) != (index >= uint(len(model))) {
+					t.Fatalf("At(%v) error = %v, model length %v", index, err, len(model))
+				}
+				if err == nil && got != model[index] {
+					t.Fatalf("At(%v) = %v, want %v", index, got, model[index])
+				}
+			case 5:
+				list.Clear()
+				model = model[:0]
+			case 6:
+				index := uint(value % 8)
+				err := list.InsertAt(index, value)
+				if (err != nil) != (index > uint(len(model))) {
+					t.Fatalf("InsertAt(%v) error = %v, model length %v", index, err, len(model))
+				}
+				if err == nil {
+					model = slices.Insert(model, int(index), value)
+				}
+			case 7:
+				index := uint(value % 8)
+				got, err := list.RemoveAt(index)
+				if (err != nil) != (index >= uint(len(model))) {
+					t.Fatalf("RemoveAt(%v) error = %v, model length %v", index, err, len(model))
+				}
+				if err == nil {
+					if got != model[index] {
+						t.Fatalf("RemoveAt(%v) = %v, want %v", index, got, model[index])
+					}
+					model = slices.Delete(model, int(index), int(index)+1)
+				}
+			case 8:
+				i, j := uint(value%8), uint(value/8%8)
+				err := list.Swap(i, j)
+				if (err != nil) != (i >= uint(len(model)) || j >= uint(len(model))) {
+					t.Fatalf("Swap(%v, %v) error = %v, model length %v", i, j, err, len(model))
+				}
+				if err == nil {
+					model[i], model[j] = model[j], model[i]
+				}
+			case 9:
+				n := value%16 - 8
+				list.Rotate(n)
+				if len(model) > 0 {
+					steps := (n%len(model) + len(model)) % len(model)
+					model = append(model[len(model)-steps:], model[:len(model)-steps]...)
+				}
+			case 10:
+				list.Reverse()
+				slices.Reverse(model)
+			}
+			checkListModel(t, list, model)
+		}
+	})
+}
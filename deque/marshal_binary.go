@@ -0,0 +1,63 @@
+package deque
+
+import (
+	"fmt"
+
+	"github.com/dukenmarga/gollection/codec"
+)
+
+// MarshalBinary encodes the list from head to tail using the default codec.
+func (list *DequeueList[T]) MarshalBinary() ([]byte, error) {
+	return list.MarshalBinaryWith(codec.Default[T]())
+}
+
+// MarshalBinaryWith encodes the list from head to tail using the given
+// value codec. The result starts with a version header and ends with a
+// checksum of the whole frame.
+func (list *DequeueList[T]) MarshalBinaryWith(valueCodec codec.Codec[T]) ([]byte, error) {
+	var err error
+	buf := codec.AppendHeader(nil, codec.KindDeque, uint64(list.length))
+	for current := list.head; current != nil; current = current.next {
+		buf, err = valueCodec.Append(buf, current.value)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	}
+	return codec.Seal(buf), nil
+}
+
+// UnmarshalBinary replaces the content of the list with
+// data encoded by MarshalBinary.
+func (list *DequeueList[T]) UnmarshalBinary(data []byte) error {
+	return list.UnmarshalBinaryWith(data, codec.Default[T]())
+}
+
+// UnmarshalBinaryWith replaces the content of the list with data encoded
+// by MarshalBinaryWith using the same value codec. The list is left
+// untouched when the data is invalid.
+func (list *DequeueList[T]) UnmarshalBinaryWith(data []byte, valueCodec codec.Codec[T]) error {
+	count, payload, err := codec.Open(data, codec.KindDeque)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	// a bounded list keeps its bound and the last values, as PushRight does
+	decoded := &DequeueList[T]{
+		maxLength: list.maxLength,
+	}
+	for i := uint64(0); i < count; i++ {
+		value, n, err := valueCodec.Decode(payload)
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		payload = payload[n:]
+		decoded.PushRight(value)
+	}
+	if len(payload) != 0 {
+		return fmt.Errorf("unexpected trailing data: %v bytes", len(payload))
+	}
+
+	list.Clear()
+	*list = *decoded
+	return nil
+}
@@ -0,0 +1,86 @@
+package deque
+
+import (
+	"testing"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	tests := []testCasePush[string]{
+		{
+			name: "Test marshal dequeue list of string values",
+			input: []string{
+				"10",
+				"",
+				"20",
+			},
+			wantDequeVal: []string{
+				"10",
+				"",
+				"20",
+			},
+		},
+		{
+			name:         "Test marshal empty dequeue list",
+			input:        []string{},
+			wantDequeVal: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := NewDequeue(tt.input).MarshalBinary()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := NewDequeue([]string{"stale"})
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Length() != uint(len(tt.wantDequeVal)) {
+				t.Errorf("actual length = %v, want length %v", got.Length(), len(tt.wantDequeVal))
+			}
+			for _, wantVal := range tt.wantDequeVal {
+				pop, _ := got.PopLeft()
+				if wantVal != pop {
+					t.Errorf("actual = %v, want %v", pop, wantVal)
+				}
+			}
+		})
+	}
+}
+
+func TestUnmarshalBinaryInvalid(t *testing.T) {
+	data, _ := NewDequeue([]int{1, 2, 3}).MarshalBinary()
+
+	list := NewDequeue([]int{9})
+	corrupted := append([]byte(nil), data...)
+	corrupted[len(corrupted)-5] ^= 0xff
+	if err := list.UnmarshalBinary(corrupted); err == nil {
+		t.Errorf("expected error for corrupted data")
+	}
+	if err := list.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Errorf("expected error for truncated data")
+	}
+
+	// The list is left untouched on error
+	if got, _ := list.At(0); list.Length() != 1 || got != 9 {
+		t.Errorf("list was modified by a failed unmarshal")
+	}
+}
+
+func TestUnmarshalBinaryBounded(t *testing.T) {
+	data, err := NewDequeue([]int{1, 2, 3, 4}).MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The bound of the receiver is kept, so only the last values fit
+	list := NewBoundedDequeue([]int{}, 2)
+	if err := list.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if list.MaxLength() != 2 {
+		t.Errorf("actual = %v, want %v", list.MaxLength(), 2)
+	}
+	checkListModel(t, list, []int{3, 4})
+}
@@ -0,0 +1,30 @@
+package deque
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON encodes the list as a JSON array, from head to tail.
+func (list *DequeueList[T]) MarshalJSON() ([]byte, error) {
+	values := make([]T, 0, list.length)
+	for current := list.head; current != nil; current = current.next {
+		values = append(values, current.value)
+	}
+	return json.Marshal(values)
+}
+
+// UnmarshalJSON replaces the content of the list with a JSON array.
+// The first element of the array becomes the head of the list.
+func (list *DequeueList[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	list.Clear()
+	for _, value := range values {
+		list.PushRight(value)
+	}
+	return nil
+}
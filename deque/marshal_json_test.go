@@ -0,0 +1,61 @@
+package deque
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  string
+	}{
+		{
+			name:  "Test marshal dequeue list as array",
+			input: []int{3, 1, 2},
+			want:  `[3,1,2]`,
+		},
+		{
+			name:  "Test marshal empty dequeue list",
+			input: []int{},
+			want:  `[]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(NewDequeue(tt.input))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("actual = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	var payload struct {
+		List *DequeueList[string] `json:"list"`
+	}
+	err := json.Unmarshal([]byte(`{"list": ["a", "b", "c"]}`), &payload)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantDequeVal := []string{"a", "b", "c"}
+	if payload.List.Length() != uint(len(wantDequeVal)) {
+		t.Errorf("actual length = %v, want length %v", payload.List.Length(), len(wantDequeVal))
+	}
+	for _, wantVal := range wantDequeVal {
+		pop, _ := payload.List.PopLeft()
+		if wantVal != pop {
+			t.Errorf("actual = %v, want %v", pop, wantVal)
+		}
+	}
+
+	if err := payload.List.UnmarshalJSON([]byte(`{"a": 1}`)); err == nil {
+		t.Errorf("expected error when decoding object into list")
+	}
+}
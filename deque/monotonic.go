@@ -0,0 +1,225 @@
+package deque
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// MonotonicQueue is a FIFO queue that also reports the greatest of its
+// values according to less in O(1). Next to the values, it keeps the
+// candidates for the greatest value in decreasing order: a pushed value
+// removes every smaller candidate, as none of them can be the greatest
+// while the pushed value is in the queue. Every value is pushed and
+// removed as a candidate once, so all operations are amortized O(1).
+// Pass a reversed less to track the smallest value instead.
+type MonotonicQueue[T any] struct {
+	values     *DequeueList[T]
+	candidates *DequeueList[candidate[T]]
+	less       func(a, b T) bool
+	pushed     uint64
+	popped     uint64
+}
+
+// candidate remembers the position of the value in the queue,
+// to tell whether a popped value is the front candidate.
+type candidate[T any] struct {
+	value T
+	seq   uint64
+}
+
+func NewMonotonicQueue[T any](less func(a, b T) bool) *MonotonicQueue[T] {
+	return &MonotonicQueue[T]{
+		values:     &DequeueList[T]{},
+		candidates: &DequeueList[candidate[T]]{},
+		less:       less,
+	}
+}
+
+// Clear removes all values
+func (queue *MonotonicQueue[T]) Clear() {
+	queue.values.Clear()
+	queue.candidates.Clear()
+	queue.popped = queue.pushed
+}
+
+// Extreme returns the greatest value in the queue according to less.
+// Of equal values, the oldest one is returned.
+func (queue *MonotonicQueue[T]) Extreme() (T, error) {
+	front, err := queue.candidates.PeekLeft()
+	if err != nil {
+		var empty T
+		return empty, fmt.Errorf("queue is empty")
+	}
+	return front.value, nil
+}
+
+// Front returns the oldest value, which is the next one to be popped.
+func (queue *MonotonicQueue[T]) Front() (T, error) {
+	value, err := queue.values.PeekLeft()
+	if err != nil {
+		return value, fmt.Errorf("queue is empty")
+	}
+	return value, nil
+}
+
+func (queue *MonotonicQueue[T]) IsEmpty() bool {
+	return queue.values.IsEmpty()
+}
+
+func (queue *MonotonicQueue[T]) Length() uint {
+	return queue.values.Length()
+}
+
+// Pop removes the oldest value and returns it.
+func (queue *MonotonicQueue[T]) Pop() (T, error) {
+	value, err := queue.values.PopLeft()
+	if err != nil {
+		return value, fmt.Errorf("queue is empty")
+	}
+
+	// the oldest value is a candidate only if no greater value came after it
+	if front := queue.candidates.Front(); front != nil && front.Value().seq == queue.popped {
+		_, _ = queue.candidates.PopLeft()
+	}
+	queue.popped++
+	return value, nil
+}
+
+// Push adds the value as the newest one.
+func (queue *MonotonicQueue[T]) Push(value T) {
+	queue.values.PushRight(value)
+
+	// equal candidates are kept, so the oldest of them is the front
+	for back := queue.candidates.Back(); back != nil && queue.less(back.Value().value, value); back = queue.candidates.Back() {
+		_, _ = queue.candidates.PopRight()
+	}
+	queue.candidates.PushRight(candidate[T]{
+		value: value,
+		seq:   queue.pushed,
+	})
+	queue.pushed++
+}
+
+// SlidingMax yields the greatest value of every window of size
+// consecutive values of seq, starting with the first full window.
+func SlidingMax[T cmp.Ordered](seq iter.Seq[T], size uint) iter.Seq[T] {
+	return SlidingExtreme(seq, size, func(a, b T) bool {
+		return a < b
+	})
+}
+
+// SlidingMin yields the smallest value of every window of size
+// consecutive values of seq, starting with the first full window.
+func SlidingMin[T cmp.Ordered](seq iter.Seq[T], size uint) iter.Seq[T] {
+	return SlidingExtreme(seq, size, func(a, b T) bool {
+		return a > b
+	})
+}
+
+// SlidingExtreme yields the greatest value according to less of every
+// window of size consecutive values of seq, starting with the first
+// full window. A size of 0 yields nothing.
+func SlidingExtreme[T any](seq iter.Seq[T], size uint, less func(a, b T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if size == 0 {
+			return
+		}
+		queue := NewMonotonicQueue(less)
+		for value := range seq {
+			queue.Push(value)
+			if queue.Length() > size {
+				_, _ = queue.Pop()
+			}
+			if queue.Length() < size {
+				continue
+			}
+			extreme, _ := queue.Extreme()
+			if !yield(extreme) {
+				return
+			}
+		}
+	}
+}
+
+// TimeWindowQueue is a MonotonicQueue over the values pushed within
+// the last window of time. Values are timestamped when pushed and
+// evicted once they are window old, before every read.
+type TimeWindowQueue[T any] struct {
+	queue  *MonotonicQueue[timedValue[T]]
+	window time.Duration
+	now    func() time.Time
+}
+
+type timedValue[T any] struct {
+	value T
+	time  time.Time
+}
+
+// NewTimeWindowQueue creates a queue keeping the values of the last
+// window of time according to now, or time.Now if now is nil.
+func NewTimeWindowQueue[T any](window time.Duration, less func(a, b T) bool, now func() time.Time) *TimeWindowQueue[T] {
+	if now == nil {
+		now = time.Now
+	}
+	return &TimeWindowQueue[T]{
+		queue: NewMonotonicQueue(func(a, b timedValue[T]) bool {
+			return less(a.value, b.value)
+		}),
+		window: window,
+		now:    now,
+	}
+}
+
+// Clear removes all values
+func (queue *TimeWindowQueue[T]) Clear() {
+	queue.queue.Clear()
+}
+
+// Extreme returns the greatest value according to less
+// of the values pushed within the window.
+func (queue *TimeWindowQueue[T]) Extreme() (T, error) {
+	queue.evict()
+	extreme, err := queue.queue.Extreme()
+	if err != nil {
+		return extreme.value, fmt.Errorf("%w", err)
+	}
+	return extreme.value, nil
+}
+
+func (queue *TimeWindowQueue[T]) IsEmpty() bool {
+	queue.evict()
+	return queue.queue.IsEmpty()
+}
+
+// Length returns the number of values pushed within the window.
+func (queue *TimeWindowQueue[T]) Length() uint {
+	queue.evict()
+	return queue.queue.Length()
+}
+
+// Push adds the value with the current time.
+func (queue *TimeWindowQueue[T]) Push(value T) {
+	now := queue.now()
+	queue.evictBefore(now)
+	queue.queue.Push(timedValue[T]{
+		value: value,
+		time:  now,
+	})
+}
+
+func (queue *TimeWindowQueue[T]) evict() {
+	queue.evictBefore(queue.now())
+}
+
+// evictBefore removes the values that are window old at now.
+func (queue *TimeWindowQueue[T]) evictBefore(now time.Time) {
+	for {
+		oldest, err := queue.queue.Front()
+		if err != nil || now.Sub(oldest.time) < queue.window {
+			return
+		}
+		_, _ = queue.queue.Pop()
+	}
+}
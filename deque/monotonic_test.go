@@ -0,0 +1,163 @@
+package deque
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestMonotonicQueue(t *testing.T) {
+	queue := NewMonotonicQueue(func(a, b int) bool {
+		return a < b
+	})
+	if _, err := queue.Extreme(); err == nil {
+		t.Errorf("expected error for an empty queue")
+	}
+
+	// Each step pushes a value or pops the oldest one (-1)
+	steps := []int{3, 1, 4, -1, 1, 5, -1, -1, -1, 2, -1}
+	wantExtremes := []int{3, 3, 4, 4, 4, 5, 5, 5, 5, 5, 2}
+	for i, step := range steps {
+		if step < 0 {
+			if _, err := queue.Pop(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		} else {
+			queue.Push(step)
+		}
+		got, err := queue.Extreme()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != wantExtremes[i] {
+			t.Errorf("step %v: actual = %v, want %v", i, got, wantExtremes[i])
+		}
+	}
+
+	if front, _ := queue.Front(); front != 2 {
+		t.Errorf("actual front = %v, want %v", front, 2)
+	}
+	queue.Clear()
+	if !queue.IsEmpty() || queue.Length() != 0 {
+		t.Errorf("queue is not empty after Clear")
+	}
+	if _, err := queue.Pop(); err == nil {
+		t.Errorf("expected error for an empty queue")
+	}
+}
+
+type testCaseSliding[T any] struct {
+	name    string
+	input   []T
+	size    uint
+	wantMax []T
+	wantMin []T
+}
+
+func TestSliding(t *testing.T) {
+	tests := []testCaseSliding[int]{
+		{
+			name:    "Test sliding window of three",
+			input:   []int{1, 3, -1, -3, 5, 3, 6, 7},
+			size:    3,
+			wantMax: []int{3, 3, 5, 5, 6, 7},
+			wantMin: []int{-1, -3, -3, -3, 3, 3},
+		},
+		{
+			name:    "Test sliding window with equal values",
+			input:   []int{2, 2, 1, 2},
+			size:    2,
+			wantMax: []int{2, 2, 2},
+			wantMin: []int{2, 1, 1},
+		},
+		{
+			name:    "Test sliding window larger than the input",
+			input:   []int{1, 2},
+			size:    3,
+			wantMax: []int{},
+			wantMin: []int{},
+		},
+		{
+			name:    "Test sliding window of zero",
+			input:   []int{1, 2},
+			size:    0,
+			wantMax: []int{},
+			wantMin: []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMax := slices.AppendSeq([]int{}, SlidingMax(slices.Values(tt.input), tt.size))
+			if !slices.Equal(gotMax, tt.wantMax) {
+				t.Errorf("actual = %v, want %v", gotMax, tt.wantMax)
+			}
+			gotMin := slices.AppendSeq([]int{}, SlidingMin(slices.Values(tt.input), tt.size))
+			if !slices.Equal(gotMin, tt.wantMin) {
+				t.Errorf("actual = %v, want %v", gotMin, tt.wantMin)
+			}
+		})
+	}
+}
+
+func TestSlidingMatchesBruteForce(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	input := make([]int, 500)
+	for i := range input {
+		input[i] = random.Intn(50)
+	}
+
+	for _, size := range []uint{1, 2, 7, 64} {
+		t.Run(fmt.Sprint(size), func(t *testing.T) {
+			i := 0
+			for got := range SlidingMax(slices.Values(input), size) {
+				want := slices.Max(input[i : i+int(size)])
+				if got != want {
+					t.Fatalf("window %v: actual = %v, want %v", i, got, want)
+				}
+				i++
+			}
+			if i != len(input)-int(size)+1 {
+				t.Errorf("actual windows = %v, want %v", i, len(input)-int(size)+1)
+			}
+		})
+	}
+}
+
+func TestTimeWindowQueue(t *testing.T) {
+	now := time.Unix(0, 0)
+	clock := func() time.Time {
+		return now
+	}
+	queue := NewTimeWindowQueue(time.Minute, func(a, b float64) bool {
+		return a < b
+	}, clock)
+
+	queue.Push(10)
+	now = now.Add(20 * time.Second)
+	queue.Push(30)
+	now = now.Add(20 * time.Second)
+	queue.Push(20)
+
+	if got, _ := queue.Extreme(); got != 30 {
+		t.Errorf("actual = %v, want %v", got, 30)
+	}
+
+	// 30 is a minute old at 80s and leaves the window
+	now = now.Add(40 * time.Second)
+	if got, _ := queue.Extreme(); got != 20 {
+		t.Errorf("actual = %v, want %v", got, 20)
+	}
+	if queue.Length() != 1 {
+		t.Errorf("actual length = %v, want length %v", queue.Length(), 1)
+	}
+
+	now = now.Add(time.Hour)
+	if !queue.IsEmpty() {
+		t.Errorf("queue is not empty after the window passed")
+	}
+	if _, err := queue.Extreme(); err == nil {
+		t.Errorf("expected error for an empty queue")
+	}
+}
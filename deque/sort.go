@@ -0,0 +1,149 @@
+package deque
+
+import (
+	"cmp"
+)
+
+// BinarySearch searches for target in a list sorted in ascending
+// order, see BinarySearchFunc.
+func BinarySearch[T cmp.Ordered](list *DequeueList[T], target T) (*Element[T], bool) {
+	return BinarySearchFunc(list, target, cmp.Compare[T])
+}
+
+// BinarySearchFunc searches for target in a list sorted in ascending
+// order according to cmp, which returns a negative number when the value
+// sorts before target, zero when it matches and a positive number after.
+// It returns the first element whose value does not sort before target
+// and whether it matches, so InsertBefore on that element keeps the list
+// sorted. The element is nil when every value sorts before target.
+// Reaching the middle of a linked list still means following links, so
+// the search takes O(n) link steps but only O(log n) comparisons.
+func BinarySearchFunc[T, U any](list *DequeueList[T], target U, cmp func(T, U) int) (*Element[T], bool) {
+	low, n := list.head, list.length
+	for n > 0 {
+		half := n / 2
+		mid := low
+		for i := uint(0); i < half; i++ {
+			mid = mid.next
+		}
+		if cmp(mid.value, target) < 0 {
+			low = mid.next
+			n -= half + 1
+		} else {
+			n = half
+		}
+	}
+	return low, low != nil && cmp(low.value, target) == 0
+}
+
+// Dedup removes consecutive values for which eq returns true, keeping
+// the first of every run, and returns how many values were removed.
+func Dedup[T any](list *DequeueList[T], eq func(a, b T) bool) uint {
+	var removed uint
+	if list.head == nil {
+		return removed
+	}
+	kept := list.head
+	current := kept.next
+	for current != nil {
+		next := current.next
+		if eq(kept.value, current.value) {
+			list.unlink(current)
+			removed++
+		} else {
+			kept = current
+		}
+		current = next
+	}
+	return removed
+}
+
+// IsSorted reports whether the values are in ascending order.
+func IsSorted[T cmp.Ordered](list *DequeueList[T]) bool {
+	return IsSortedFunc(list, cmp.Less[T])
+}
+
+// IsSortedFunc reports whether the values are in ascending order
+// according to less.
+func IsSortedFunc[T any](list *DequeueList[T], less func(a, b T) bool) bool {
+	for current := list.head; current != nil && current.next != nil; current = current.next {
+		if less(current.next.value, current.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Sort sorts the values in ascending order, see SortFunc.
+func Sort[T cmp.Ordered](list *DequeueList[T]) {
+	SortFunc(list, cmp.Less[T])
+}
+
+// SortFunc sorts the values in ascending order according to less.
+// It is a stable bottom-up merge sort that relinks the nodes, so it
+// takes O(n log n) time and O(1) extra memory, and elements keep
+// their values.
+func SortFunc[T any](list *DequeueList[T], less func(a, b T) bool) {
+	if list.length < 2 {
+		return
+	}
+
+	// Merge runs of size nodes in pairs, following only the next links,
+	// until a single run is left
+	head := list.head
+	for size := uint(1); ; size *= 2 {
+		var newHead, tail *Element[T]
+		merges := 0
+		left := head
+		for left != nil {
+			merges++
+
+			// the right run starts size nodes after the left run
+			right := left
+			var leftSize uint
+			for leftSize < size && right != nil {
+				leftSize++
+				right = right.next
+			}
+			rightSize := size
+
+			for leftSize > 0 || (rightSize > 0 && right != nil) {
+				// the left run wins ties, which keeps the sort stable
+				var next *Element[T]
+				if leftSize == 0 {
+					next, right = right, right.next
+					rightSize--
+				} else if rightSize == 0 || right == nil || !less(right.value, left.value) {
+					next, left = left, left.next
+					leftSize--
+				} else {
+					next, right = right, right.next
+					rightSize--
+				}
+
+				if tail == nil {
+					newHead = next
+				} else {
+					tail.next = next
+				}
+				tail = next
+			}
+			left = right
+		}
+		tail.next = nil
+		head = newHead
+
+		if merges <= 1 {
+			break
+		}
+	}
+
+	// Restore the prev links, head and tail
+	var prev *Element[T]
+	for current := head; current != nil; current = current.next {
+		current.prev = prev
+		prev = current
+	}
+	list.head = head
+	list.tail = prev
+}
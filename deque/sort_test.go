@@ -0,0 +1,266 @@
+package deque
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+type testCaseSort[T any] struct {
+	name         string
+	input        []T
+	wantSorted   bool
+	wantDequeVal []T
+}
+
+func TestSort(t *testing.T) {
+	tests := []testCaseSort[int]{
+		{
+			name:         "Test sort unsorted values",
+			input:        []int{5, 2, 9, 1, 5, 6, 3},
+			wantSorted:   false,
+			wantDequeVal: []int{1, 2, 3, 5, 5, 6, 9},
+		},
+		{
+			name:         "Test sort reversed values",
+			input:        []int{4, 3, 2, 1},
+			wantSorted:   false,
+			wantDequeVal: []int{1, 2, 3, 4},
+		},
+		{
+			name:         "Test sort sorted values",
+			input:        []int{1, 2, 2, 3},
+			wantSorted:   true,
+			wantDequeVal: []int{1, 2, 2, 3},
+		},
+		{
+			name:         "Test sort single value",
+			input:        []int{1},
+			wantSorted:   true,
+			wantDequeVal: []int{1},
+		},
+		{
+			name:         "Test sort empty list",
+			input:        []int{},
+			wantSorted:   true,
+			wantDequeVal: []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewDequeue(tt.input)
+			if got := IsSorted(list); got != tt.wantSorted {
+				t.Errorf("actual = %v, want %v", got, tt.wantSorted)
+			}
+			Sort(list)
+			checkListModel(t, list, tt.wantDequeVal)
+			if !IsSorted(list) {
+				t.Errorf("list is not sorted after Sort")
+			}
+		})
+	}
+}
+
+func TestSortFuncIsStable(t *testing.T) {
+	type pair struct {
+		key   int
+		order int
+	}
+	random := rand.New(rand.NewSource(1))
+	for _, length := range []int{2, 3, 17, 100, 257} {
+		input := make([]pair, length)
+		for i := range input {
+			input[i] = pair{key: random.Intn(10), order: i}
+		}
+		list := NewDequeue(input)
+		elements := []*Element[pair]{}
+		for element := list.Front(); element != nil; element = element.Next() {
+			elements = append(elements, element)
+		}
+
+		less := func(a, b pair) bool {
+			return a.key < b.key
+		}
+		SortFunc(list, less)
+		want := slices.Clone(input)
+		slices.SortStableFunc(want, func(a, b pair) int {
+			return a.key - b.key
+		})
+		if got := ToSlice(list); !slices.Equal(got, want) {
+			t.Fatalf("length %v: actual = %v, want %v", length, got, want)
+		}
+
+		// The nodes are relinked, so the elements keep their values
+		for _, element := range elements {
+			if element.Value() != input[element.Value().order] {
+				t.Fatalf("element changed its value to %v", element.Value())
+			}
+		}
+		if list.Back().Next() != nil || list.Front().Prev() != nil {
+			t.Fatalf("ends of the list are still linked")
+		}
+		if err := list.MoveToFront(list.Back()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+type testCaseDedup[T any] struct {
+	name         string
+	input        []T
+	wantRemoved  uint
+	wantDequeVal []T
+}
+
+func TestDedup(t *testing.T) {
+	tests := []testCaseDedup[int]{
+		{
+			name:         "Test dedup consecutive runs",
+			input:        []int{1, 1, 2, 3, 3, 3, 1},
+			wantRemoved:  3,
+			wantDequeVal: []int{1, 2, 3, 1},
+		},
+		{
+			name:         "Test dedup without duplicates",
+			input:        []int{1, 2, 1},
+			wantRemoved:  0,
+			wantDequeVal: []int{1, 2, 1},
+		},
+		{
+			name:         "Test dedup single run",
+			input:        []int{4, 4, 4},
+			wantRemoved:  2,
+			wantDequeVal: []int{4},
+		},
+		{
+			name:         "Test dedup empty list",
+			input:        []int{},
+			wantRemoved:  0,
+			wantDequeVal: []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewDequeue(tt.input)
+			removed := Dedup(list, func(a, b int) bool {
+				return a == b
+			})
+			if removed != tt.wantRemoved {
+				t.Errorf("actual = %v, want %v", removed, tt.wantRemoved)
+			}
+			checkListModel(t, list, tt.wantDequeVal)
+		})
+	}
+}
+
+type testCaseBinarySearch[T any] struct {
+	name      string
+	input     []T
+	target    T
+	wantIndex uint
+	wantFound bool
+}
+
+// indexOf returns the index of the element, or the length of
+// the list for nil.
+func indexOf[T any](list *DequeueList[T], element *Element[T]) uint {
+	var index uint
+	for current := list.head; current != element; current = current.next {
+		index++
+	}
+	return index
+}
+
+func TestBinarySearch(t *testing.T) {
+	tests := []testCaseBinarySearch[int]{
+		{
+			name:      "Test binary search existing value",
+			input:     []int{1, 3, 5, 7, 9},
+			target:    7,
+			wantIndex: 3,
+			wantFound: true,
+		},
+		{
+			name:      "Test binary search first of duplicates",
+			input:     []int{1, 3, 3, 3, 9},
+			target:    3,
+			wantIndex: 1,
+			wantFound: true,
+		},
+		{
+			name:      "Test binary search missing value",
+			input:     []int{1, 3, 5, 7, 9},
+			target:    4,
+			wantIndex: 2,
+			wantFound: false,
+		},
+		{
+			name:      "Test binary search past the tail",
+			input:     []int{1, 3, 5},
+			target:    6,
+			wantIndex: 3,
+			wantFound: false,
+		},
+		{
+			name:      "Test binary search empty list",
+			input:     []int{},
+			target:    1,
+			wantIndex: 0,
+			wantFound: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewDequeue(tt.input)
+			element, found := BinarySearch(list, tt.target)
+			if index := indexOf(list, element); index != tt.wantIndex || found != tt.wantFound {
+				t.Errorf("actual = %v, %v, want %v, %v", index, found, tt.wantIndex, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestBinarySearchFunc(t *testing.T) {
+	values := make([]int, 100)
+	for i := range values {
+		values[i] = rand.Intn(50)
+	}
+	slices.Sort(values)
+	list := NewDequeue(values)
+
+	type person struct {
+		age int
+	}
+	people := NewDequeue([]person{})
+	for _, value := range values {
+		people.PushRight(person{age: value})
+	}
+
+	for target := -1; target <= 51; target++ {
+		wantIndex, wantFound := slices.BinarySearch(values, target)
+
+		comparisons := 0
+		element, found := BinarySearchFunc(people, target, func(p person, age int) int {
+			comparisons++
+			return p.age - age
+		})
+		if index := indexOf(people, element); index != uint(wantIndex) || found != wantFound {
+			t.Errorf("target %v: actual = %v, %v, want %v, %v", target, index, found, wantIndex, wantFound)
+		}
+		// 100 values need at most 7 halvings, plus the final match check
+		if comparisons > 8 {
+			t.Errorf("target %v: actual comparisons = %v, want at most %v", target, comparisons, 8)
+		}
+
+		// Inserting before the element found keeps the list sorted
+		at, _ := BinarySearch(list, target)
+		if at == nil {
+			list.PushRight(target)
+		} else if _, err := list.InsertBefore(target, at); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if !IsSorted(list) {
+		t.Errorf("list is not sorted after inserting at the search results")
+	}
+}
@@ -0,0 +1,142 @@
+package deque
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Concat moves the nodes of other to the right of the list in O(1),
+// leaving other empty. If the list is bounded, the values that no
+// longer fit are evicted from the head, as PushRight does.
+// Concatenating a list with itself does nothing.
+func (list *DequeueList[T]) Concat(other *DequeueList[T]) {
+	if other == nil || other == list || other.head == nil {
+		return
+	}
+
+	if list.tail == nil {
+		list.head = other.head
+	} else {
+		list.tail.next = other.head
+		other.head.prev = list.tail
+	}
+	list.tail = other.tail
+	list.length += other.length
+
+	// the moved elements now belong to the list through the old owner
+	// of other, while other starts over with a new owner
+	other.owner.forward = list.id()
+	other.owner = nil
+	other.head = nil
+	other.tail = nil
+	other.length = 0
+
+	for list.maxLength > 0 && list.length > list.maxLength {
+		_, _ = list.PopLeft()
+	}
+}
+
+// ExtendLeft pushes the values to the left one by one, so they end up
+// in reverse order at the head, like extendleft of a Python deque.
+func (list *DequeueList[T]) ExtendLeft(values ...T) {
+	for _, value := range values {
+		list.PushLeft(value)
+	}
+}
+
+// ExtendLeftSeq is ExtendLeft for the values of seq.
+func (list *DequeueList[T]) ExtendLeftSeq(seq iter.Seq[T]) {
+	for value := range seq {
+		list.PushLeft(value)
+	}
+}
+
+// ExtendRight pushes the values to the right in order.
+func (list *DequeueList[T]) ExtendRight(values ...T) {
+	for _, value := range values {
+		list.PushRight(value)
+	}
+}
+
+// ExtendRightSeq is ExtendRight for the values of seq.
+func (list *DequeueList[T]) ExtendRightSeq(seq iter.Seq[T]) {
+	for value := range seq {
+		list.PushRight(value)
+	}
+}
+
+// MoveToBack moves the element, which must belong to the list, to the tail.
+func (list *DequeueList[T]) MoveToBack(element *Element[T]) error {
+	if !list.owns(element) {
+		return fmt.Errorf("element does not belong to this list")
+	}
+	if element == list.tail {
+		return nil
+	}
+	list.unlink(element)
+	element.owner = list.id()
+	list.linkAfter(element, list.tail)
+	return nil
+}
+
+// MoveToFront moves the element, which must belong to the list, to the head.
+func (list *DequeueList[T]) MoveToFront(element *Element[T]) error {
+	if !list.owns(element) {
+		return fmt.Errorf("element does not belong to this list")
+	}
+	if element == list.head {
+		return nil
+	}
+	list.unlink(element)
+	element.owner = list.id()
+	list.linkBefore(element, list.head)
+	return nil
+}
+
+// SplitAt cuts the list in front of index. The list keeps the values
+// before index and the values from index onwards are moved to the
+// returned list. Finding the node and handing the shorter side to a new
+// owner both take O(min(index, length-index)), the cut itself is O(1).
+func (list *DequeueList[T]) SplitAt(index uint) (*DequeueList[T], error) {
+	if index == list.length {
+		return &DequeueList[T]{}, nil
+	}
+	node, err := list.nodeAt(index)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	rest := &DequeueList[T]{
+		head:   node,
+		tail:   list.tail,
+		length: list.length - index,
+	}
+
+	list.tail = node.prev
+	if list.tail == nil {
+		list.head = nil
+	} else {
+		list.tail.next = nil
+	}
+	node.prev = nil
+	list.length = index
+
+	// One side needs a new owner, so relabel the shorter one.
+	// The rest takes over the owner when the list is shorter.
+	if rest.length <= list.length {
+		rest.relabel()
+	} else {
+		rest.owner = list.owner
+		list.relabel()
+	}
+
+	return rest, nil
+}
+
+// relabel gives the list a new owner and points all elements at it.
+func (list *DequeueList[T]) relabel() {
+	list.owner = &owner{}
+	for current := list.head; current != nil; current = current.next {
+		current.owner = list.owner
+	}
+}
@@ -0,0 +1,180 @@
+package deque
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+type testCaseConcat[T any] struct {
+	name          string
+	input         []T
+	inputOther    []T
+	maxLength     uint
+	wantDequeVal  []T
+	wantOtherVals []T
+}
+
+func TestConcat(t *testing.T) {
+	tests := []testCaseConcat[int]{
+		{
+			name:          "Test concat two lists",
+			input:         []int{1, 2},
+			inputOther:    []int{3, 4},
+			wantDequeVal:  []int{1, 2, 3, 4},
+			wantOtherVals: []int{},
+		},
+		{
+			name:          "Test concat into empty list",
+			input:         []int{},
+			inputOther:    []int{3, 4},
+			wantDequeVal:  []int{3, 4},
+			wantOtherVals: []int{},
+		},
+		{
+			name:          "Test concat empty list",
+			input:         []int{1, 2},
+			inputOther:    []int{},
+			wantDequeVal:  []int{1, 2},
+			wantOtherVals: []int{},
+		},
+		{
+			name:          "Test concat into bounded list evicts the head",
+			input:         []int{1, 2},
+			inputOther:    []int{3, 4},
+			maxLength:     3,
+			wantDequeVal:  []int{2, 3, 4},
+			wantOtherVals: []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewBoundedDequeue(tt.input, tt.maxLength)
+			other := NewDequeue(tt.inputOther)
+			list.Concat(other)
+			checkListModel(t, list, tt.wantDequeVal)
+			checkListModel(t, other, tt.wantOtherVals)
+		})
+	}
+
+	// A list concatenated with itself is left as it is
+	list := NewDequeue([]int{1, 2})
+	list.Concat(list)
+	checkListModel(t, list, []int{1, 2})
+}
+
+type testCaseSplitAt[T any] struct {
+	name         string
+	input        []T
+	inputIndex   uint
+	wantErr      error
+	wantDequeVal []T
+	wantRestVal  []T
+}
+
+func TestSplitAt(t *testing.T) {
+	tests := []testCaseSplitAt[int]{
+		{
+			name:         "Test split in the middle",
+			input:        []int{1, 2, 3, 4, 5},
+			inputIndex:   2,
+			wantDequeVal: []int{1, 2},
+			wantRestVal:  []int{3, 4, 5},
+		},
+		{
+			name:         "Test split at head",
+			input:        []int{1, 2, 3},
+			inputIndex:   0,
+			wantDequeVal: []int{},
+			wantRestVal:  []int{1, 2, 3},
+		},
+		{
+			name:         "Test split after tail",
+			input:        []int{1, 2, 3},
+			inputIndex:   3,
+			wantDequeVal: []int{1, 2, 3},
+			wantRestVal:  []int{},
+		},
+		{
+			name:         "Test split out of range",
+			input:        []int{1, 2, 3},
+			inputIndex:   4,
+			wantErr:      fmt.Errorf("index out of range: 4 (total length: 3)"),
+			wantDequeVal: []int{1, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewDequeue(tt.input)
+			rest, err := list.SplitAt(tt.inputIndex)
+			if (err != nil) != (tt.wantErr != nil) {
+				t.Fatalf("actual = %v, want %v", err, tt.wantErr)
+			}
+			checkListModel(t, list, tt.wantDequeVal)
+			if err != nil {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("actual = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			checkListModel(t, rest, tt.wantRestVal)
+		})
+	}
+}
+
+func TestExtend(t *testing.T) {
+	list := NewDequeue([]int{3})
+	list.ExtendLeft(2, 1)
+	list.ExtendRight(4, 5)
+	checkListModel(t, list, []int{1, 2, 3, 4, 5})
+
+	list.ExtendLeftSeq(slices.Values([]int{0, -1}))
+	list.ExtendRightSeq(slices.Values([]int{6, 7}))
+	checkListModel(t, list, []int{-1, 0, 1, 2, 3, 4, 5, 6, 7})
+}
+
+func TestMoveToFrontAndBack(t *testing.T) {
+	list := NewDequeue([]int{1, 2, 3, 4})
+
+	// 3 is the third node from the head
+	node := list.Front().Next().Next()
+	if err := list.MoveToFront(node); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkListModel(t, list, []int{3, 1, 2, 4})
+
+	if err := list.MoveToBack(list.Front()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkListModel(t, list, []int{1, 2, 4, 3})
+
+	// Moving a node already at that end changes nothing
+	if err := list.MoveToBack(list.Back()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := list.MoveToFront(list.Front()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkListModel(t, list, []int{1, 2, 4, 3})
+
+	// A node removed from the list is rejected
+	removed := list.Back()
+	_, _ = list.PopRight()
+	if err := list.MoveToFront(removed); err == nil {
+		t.Errorf("expected error when moving a removed node")
+	}
+	if err := list.MoveToBack(nil); err == nil {
+		t.Errorf("expected error when moving a nil node")
+	}
+	checkListModel(t, list, []int{1, 2, 4})
+}
+
+func TestMoveAfterClear(t *testing.T) {
+	list := NewDequeue([]int{1, 2, 3})
+	node := list.Back()
+	list.Clear()
+	if err := list.MoveToFront(node); err == nil {
+		t.Errorf("expected error when moving a node of a cleared list")
+	}
+	checkListModel(t, list, []int{})
+}
@@ -0,0 +1,94 @@
+package deque
+
+import (
+	"encoding/gob"
+	"fmt"
+	"io"
+
+	"github.com/dukenmarga/gollection/codec"
+)
+
+// RegisterGob registers the list type with encoding/gob, which is only
+// needed when the list is sent as the value of an interface field.
+func RegisterGob[T any]() {
+	gob.Register(&DequeueList[T]{})
+}
+
+// GobDecode implements gob.GobDecoder using UnmarshalBinary.
+func (list *DequeueList[T]) GobDecode(data []byte) error {
+	return list.UnmarshalBinary(data)
+}
+
+// GobEncode implements gob.GobEncoder using MarshalBinary.
+func (list *DequeueList[T]) GobEncode() ([]byte, error) {
+	return list.MarshalBinary()
+}
+
+// ReadFrom replaces the content of the list with a stream
+// written by WriteTo, using the default codec.
+func (list *DequeueList[T]) ReadFrom(r io.Reader) (int64, error) {
+	return list.ReadFromWith(r, codec.Default[T]())
+}
+
+// ReadFromWith replaces the content of the list with a stream written by
+// WriteToWith using the same codec. Values are decoded one at a time as
+// they are read. The list is left untouched when the stream is invalid.
+func (list *DequeueList[T]) ReadFromWith(r io.Reader, valueCodec codec.Codec[T]) (int64, error) {
+	sr, err := codec.NewReader(r, codec.KindDequeStream)
+	if err != nil {
+		return sr.BytesRead(), fmt.Errorf("%w", err)
+	}
+
+	// a bounded list keeps its bound and the last values, as PushRight does
+	decoded := &DequeueList[T]{
+		maxLength: list.maxLength,
+	}
+	for i := uint64(0); i < sr.Count(); i++ {
+		record, err := sr.ReadRecord()
+		if err != nil {
+			return sr.BytesRead(), fmt.Errorf("%w", err)
+		}
+		value, n, err := valueCodec.Decode(record)
+		if err != nil {
+			return sr.BytesRead(), fmt.Errorf("%w", err)
+		}
+		if n != len(record) {
+			return sr.BytesRead(), fmt.Errorf("unexpected trailing data in record %v", i)
+		}
+		decoded.PushRight(value)
+	}
+	if err := sr.Close(); err != nil {
+		return sr.BytesRead(), fmt.Errorf("%w", err)
+	}
+
+	list.Clear()
+	*list = *decoded
+	return sr.BytesRead(), nil
+}
+
+// WriteTo writes the list from head to tail to w, using the default codec.
+func (list *DequeueList[T]) WriteTo(w io.Writer) (int64, error) {
+	return list.WriteToWith(w, codec.Default[T]())
+}
+
+// WriteToWith writes the list from head to tail to w using the given
+// codec. Values are encoded and written one at a time, so the list
+// is never copied in memory.
+func (list *DequeueList[T]) WriteToWith(w io.Writer, valueCodec codec.Codec[T]) (int64, error) {
+	sw, err := codec.NewWriter(w, codec.KindDequeStream, uint64(list.length))
+	if err != nil {
+		return sw.BytesWritten(), fmt.Errorf("%w", err)
+	}
+	for current := list.head; current != nil; current = current.next {
+		err = sw.WriteRecord(func(buf []byte) ([]byte, error) {
+			return valueCodec.Append(buf, current.value)
+		})
+		if err != nil {
+			return sw.BytesWritten(), fmt.Errorf("%w", err)
+		}
+	}
+	if err := sw.Close(); err != nil {
+		return sw.BytesWritten(), fmt.Errorf("%w", err)
+	}
+	return sw.BytesWritten(), nil
+}
@@ -0,0 +1,87 @@
+package deque
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+)
+
+func TestWriteToReadFrom(t *testing.T) {
+	tests := []testCasePush[string]{
+		{
+			name: "Test stream dequeue list of string values",
+			input: []string{
+				"10",
+				"",
+				"20",
+			},
+			wantDequeVal: []string{
+				"10",
+				"",
+				"20",
+			},
+		},
+		{
+			name:         "Test stream empty dequeue list",
+			input:        []string{},
+			wantDequeVal: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			written, err := NewDequeue(tt.input).WriteTo(&buf)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if written != int64(buf.Len()) {
+				t.Errorf("actual written = %v, want %v", written, buf.Len())
+			}
+
+			got := NewDequeue([]string{"stale"})
+			read, err := got.ReadFrom(&buf)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if read != written {
+				t.Errorf("actual read = %v, want %v", read, written)
+			}
+			if got.Length() != uint(len(tt.wantDequeVal)) {
+				t.Errorf("actual length = %v, want length %v", got.Length(), len(tt.wantDequeVal))
+			}
+			for _, wantVal := range tt.wantDequeVal {
+				pop, _ := got.PopLeft()
+				if wantVal != pop {
+					t.Errorf("actual = %v, want %v", pop, wantVal)
+				}
+			}
+		})
+	}
+}
+
+func TestGob(t *testing.T) {
+	type payload struct {
+		Name string
+		List *DequeueList[int]
+	}
+
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(payload{Name: "queue", List: NewDequeue([]int{3, 1, 2})})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got payload
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Name != "queue" || got.List.Length() != 3 {
+		t.Fatalf("actual = %+v, want 3 values", got)
+	}
+	for _, wantVal := range []int{3, 1, 2} {
+		pop, _ := got.List.PopLeft()
+		if wantVal != pop {
+			t.Errorf("actual = %v, want %v", pop, wantVal)
+		}
+	}
+}
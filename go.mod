@@ -1,3 +1,3 @@
 module github.com/dukenmarga/gollection
 
-go 1.22.10
+go 1.24
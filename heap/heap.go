@@ -0,0 +1,216 @@
+package heap
+
+import "fmt"
+
+// Heap is a binary heap stored in a slice. The element for which
+// less reports true against every other element is kept at the top,
+// so a less of a < b gives a min-heap and a > b gives a max-heap.
+type Heap[T any] struct {
+	items    []T
+	less     func(a, b T) bool
+	setIndex func(value T, index int)
+}
+
+// NewHeap builds a heap from the list in O(n).
+// The list is copied, so the caller can keep using it.
+func NewHeap[T any](list []T, less func(a, b T) bool) *Heap[T] {
+	return NewHeapWith(list, less, nil)
+}
+
+// NewHeapWith builds a heap like NewHeap and calls setIndex with the
+// new index of an element every time it moves, and with -1 when it
+// leaves the heap. Storing the index in the element, usually a pointer,
+// is what gives Fix and Remove the index of a given element.
+func NewHeapWith[T any](list []T, less func(a, b T) bool, setIndex func(value T, index int)) *Heap[T] {
+	h := &Heap[T]{
+		items:    append([]T(nil), list...),
+		less:     less,
+		setIndex: setIndex,
+	}
+	for i := range h.items {
+		h.track(i)
+	}
+	heapify(h, len(h.items))
+	return h
+}
+
+// Clear removes all elements from the heap
+func (h *Heap[T]) Clear() {
+	if h.setIndex != nil {
+		for _, value := range h.items {
+			h.setIndex(value, -1)
+		}
+	}
+	clear(h.items)
+	h.items = h.items[:0]
+}
+
+// Fix re-establishes the heap ordering after the element at index
+// has been changed in place. The index is the one last reported to
+// the setIndex callback of NewHeapWith.
+func (h *Heap[T]) Fix(index int) error {
+	if index < 0 || index >= len(h.items) {
+		return fmt.Errorf("index out of range: %v (total length: %v)", index, len(h.items))
+	}
+	fix(h, index, len(h.items))
+	return nil
+}
+
+func (h *Heap[T]) IsEmpty() bool {
+	return len(h.items) == 0
+}
+
+func (h *Heap[T]) Length() uint {
+	return uint(len(h.items))
+}
+
+// Peek returns the top element without removing it.
+func (h *Heap[T]) Peek() (T, error) {
+	if len(h.items) == 0 {
+		var empty T
+		return empty, fmt.Errorf("heap is empty")
+	}
+	return h.items[0], nil
+}
+
+// Pop removes and returns the top element.
+func (h *Heap[T]) Pop() (T, error) {
+	if len(h.items) == 0 {
+		var empty T
+		return empty, fmt.Errorf("heap is empty")
+	}
+	n := len(h.items) - 1
+	h.swap(0, n)
+	siftDown(h, 0, n)
+	return h.pop(), nil
+}
+
+// Push adds a new element to the heap in O(log n).
+func (h *Heap[T]) Push(value T) {
+	h.items = append(h.items, value)
+	h.track(len(h.items) - 1)
+	siftUp(h, len(h.items)-1)
+}
+
+// PushPop pushes the value and then pops the top element.
+// It is faster than calling Push followed by Pop, because the value is
+// returned straight away when it would be the new top.
+func (h *Heap[T]) PushPop(value T) T {
+	if len(h.items) == 0 || !h.less(h.items[0], value) {
+		return value
+	}
+	top := h.items[0]
+	h.items[0] = value
+	if h.setIndex != nil {
+		h.setIndex(top, -1)
+	}
+	h.track(0)
+	siftDown(h, 0, len(h.items))
+	return top
+}
+
+// Remove removes and returns the element at index, see Fix.
+func (h *Heap[T]) Remove(index int) (T, error) {
+	if index < 0 || index >= len(h.items) {
+		var empty T
+		return empty, fmt.Errorf("index out of range: %v (total length: %v)", index, len(h.items))
+	}
+	n := len(h.items) - 1
+	if n != index {
+		h.swap(index, n)
+		fix(h, index, n)
+	}
+	return h.pop(), nil
+}
+
+// Values returns the elements in heap order, which is not sorted order.
+func (h *Heap[T]) Values() []T {
+	return append([]T(nil), h.items...)
+}
+
+func (h *Heap[T]) lessAt(i, j int) bool {
+	return h.less(h.items[i], h.items[j])
+}
+
+// pop drops the last element, clearing its slot so it can be
+// garbage collected.
+func (h *Heap[T]) pop() T {
+	n := len(h.items) - 1
+	value := h.items[n]
+	var empty T
+	h.items[n] = empty
+	h.items = h.items[:n]
+	if h.setIndex != nil {
+		h.setIndex(value, -1)
+	}
+	return value
+}
+
+func (h *Heap[T]) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.track(i)
+	h.track(j)
+}
+
+// track reports the index of the element at index to setIndex.
+func (h *Heap[T]) track(index int) {
+	if h.setIndex != nil {
+		h.setIndex(h.items[index], index)
+	}
+}
+
+// sortable is what the sift helpers need from a heap:
+// comparing and swapping two elements by index.
+type sortable interface {
+	lessAt(i, j int) bool
+	swap(i, j int)
+}
+
+// heapify establishes the heap ordering on the first n elements
+// by sifting down every parent, starting from the last one.
+func heapify(h sortable, n int) {
+	for i := n/2 - 1; i >= 0; i-- {
+		siftDown(h, i, n)
+	}
+}
+
+// fix moves the element at index up or down until the
+// heap ordering holds again.
+func fix(h sortable, index, n int) {
+	if !siftDown(h, index, n) {
+		siftUp(h, index)
+	}
+}
+
+func siftUp(h sortable, j int) {
+	for j > 0 {
+		parent := (j - 1) / 2
+		if !h.lessAt(j, parent) {
+			break
+		}
+		h.swap(parent, j)
+		j = parent
+	}
+}
+
+// siftDown moves the element at i0 down within the first n elements
+// and reports whether it moved.
+func siftDown(h sortable, i0, n int) bool {
+	i := i0
+	for {
+		left := 2*i + 1
+		if left >= n {
+			break
+		}
+		child := left
+		if right := left + 1; right < n && h.lessAt(right, left) {
+			child = right
+		}
+		if !h.lessAt(child, i) {
+			break
+		}
+		h.swap(i, child)
+		i = child
+	}
+	return i > i0
+}
@@ -0,0 +1,179 @@
+package heap
+
+import (
+	"testing"
+)
+
+type testHeap[T any] struct {
+	name    string
+	input   []T
+	push    []T
+	wantPop []T
+}
+
+func lessInt(a, b int) bool {
+	return a < b
+}
+
+func TestNewHeap(t *testing.T) {
+	tests := []testHeap[int]{
+		{
+			name: "Test heapify from unsorted list",
+			input: []int{
+				5, 6, 2, 10, 12, 3, 1, 9,
+			},
+			wantPop: []int{
+				1, 2, 3, 5, 6, 9, 10, 12,
+			},
+		},
+		{
+			name: "Test heapify with duplicates",
+			input: []int{
+				3, 1, 3, 1, 2,
+			},
+			wantPop: []int{
+				1, 1, 2, 3, 3,
+			},
+		},
+		{
+			name: "Test heapify then push",
+			input: []int{
+				5, 3,
+			},
+			push: []int{
+				4, 1, 6,
+			},
+			wantPop: []int{
+				1, 3, 4, 5, 6,
+			},
+		},
+		{
+			name:    "Test heapify from empty list",
+			input:   []int{},
+			wantPop: []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHeap(tt.input, lessInt)
+			for _, value := range tt.push {
+				h.Push(value)
+			}
+			if h.Length() != uint(len(tt.wantPop)) {
+				t.Errorf("actual length = %v, want length %v", h.Length(), len(tt.wantPop))
+			}
+			for _, wantVal := range tt.wantPop {
+				peek, _ := h.Peek()
+				pop, err := h.Pop()
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if pop != wantVal || peek != wantVal {
+					t.Errorf("actual = %v (peek %v), want %v", pop, peek, wantVal)
+				}
+			}
+			if _, err := h.Pop(); err == nil {
+				t.Errorf("expected error when popping empty heap")
+			}
+		})
+	}
+}
+
+func TestHeapPushPop(t *testing.T) {
+	h := NewHeap([]int{5, 3, 8}, lessInt)
+	if got := h.PushPop(1); got != 1 {
+		t.Errorf("actual = %v, want %v", got, 1)
+	}
+	if got := h.PushPop(4); got != 3 {
+		t.Errorf("actual = %v, want %v", got, 3)
+	}
+	want := []int{4, 5, 8}
+	for _, wantVal := range want {
+		if got, _ := h.Pop(); got != wantVal {
+			t.Errorf("actual = %v, want %v", got, wantVal)
+		}
+	}
+}
+
+func TestHeapFixAndRemove(t *testing.T) {
+	items := []int{5, 6, 2, 10, 12, 3, 1, 9}
+	h := NewHeap(items, func(a, b int) bool { return a > b })
+
+	// Lower the top so it has to sift down
+	h.items[0] = 0
+	if err := h.Fix(0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := h.Fix(99); err == nil {
+		t.Errorf("expected error when fixing out of range index")
+	}
+
+	// Remove the last slot, then an inner one
+	_, _ = h.Remove(int(h.Length()) - 1)
+	_, _ = h.Remove(1)
+	if _, err := h.Remove(99); err == nil {
+		t.Errorf("expected error when removing out of range index")
+	}
+
+	prev, _ := h.Pop()
+	for !h.IsEmpty() {
+		next, _ := h.Pop()
+		if next > prev {
+			t.Errorf("heap order broken: %v popped after %v", next, prev)
+		}
+		prev = next
+	}
+}
+
+type indexedTask struct {
+	priority int
+	index    int
+}
+
+func TestHeapWithIndex(t *testing.T) {
+	tasks := []*indexedTask{{priority: 5}, {priority: 6}, {priority: 2}, {priority: 10}, {priority: 12}}
+	h := NewHeapWith(tasks,
+		func(a, b *indexedTask) bool { return a.priority < b.priority },
+		func(task *indexedTask, index int) { task.index = index },
+	)
+	extra := &indexedTask{priority: 3}
+	h.Push(extra)
+
+	checkIndexes := func() {
+		t.Helper()
+		for i, task := range h.items {
+			if task.index != i {
+				t.Errorf("actual index of %v = %v, want %v", task.priority, task.index, i)
+			}
+		}
+	}
+	checkIndexes()
+
+	// Raise a task through its tracked index
+	tasks[3].priority = 1
+	if err := h.Fix(tasks[3].index); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkIndexes()
+	if top, _ := h.Peek(); top != tasks[3] {
+		t.Errorf("actual top = %v, want %v", top.priority, tasks[3].priority)
+	}
+
+	removed, err := h.Remove(extra.index)
+	if err != nil || removed != extra {
+		t.Fatalf("actual = %v, %v, want %v", removed, err, extra)
+	}
+	if extra.index != -1 {
+		t.Errorf("actual index of removed task = %v, want %v", extra.index, -1)
+	}
+	checkIndexes()
+
+	want := []int{1, 2, 5, 6, 12}
+	for _, wantVal := range want {
+		task, _ := h.Pop()
+		if task.priority != wantVal || task.index != -1 {
+			t.Errorf("actual = %v at %v, want %v at %v", task.priority, task.index, wantVal, -1)
+		}
+		checkIndexes()
+	}
+}
@@ -0,0 +1,197 @@
+package heap
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// MinMaxHeap is a double-ended priority queue stored in a slice.
+// Elements on even levels are smaller than all of their descendants and
+// elements on odd levels are greater than all of their descendants, so
+// both the smallest and the greatest element can be reached in O(1) and
+// removed in O(log n). Much like PopLeft/PopRight on a DequeueList,
+// PopMin/PopMax take from either end of the ordering.
+type MinMaxHeap[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+// NewMinMaxHeap builds a min-max heap from the list in O(n).
+// The list is copied, so the caller can keep using it.
+func NewMinMaxHeap[T any](list []T, less func(a, b T) bool) *MinMaxHeap[T] {
+	h := &MinMaxHeap[T]{
+		items: append([]T(nil), list...),
+		less:  less,
+	}
+	for i := len(h.items)/2 - 1; i >= 0; i-- {
+		h.pushDown(i)
+	}
+	return h
+}
+
+// Clear removes all elements from the heap
+func (h *MinMaxHeap[T]) Clear() {
+	clear(h.items)
+	h.items = h.items[:0]
+}
+
+func (h *MinMaxHeap[T]) IsEmpty() bool {
+	return len(h.items) == 0
+}
+
+func (h *MinMaxHeap[T]) Length() uint {
+	return uint(len(h.items))
+}
+
+// PeekMax returns the greatest element without removing it.
+func (h *MinMaxHeap[T]) PeekMax() (T, error) {
+	if len(h.items) == 0 {
+		var empty T
+		return empty, fmt.Errorf("heap is empty")
+	}
+	return h.items[h.maxIndex()], nil
+}
+
+// PeekMin returns the smallest element without removing it.
+func (h *MinMaxHeap[T]) PeekMin() (T, error) {
+	if len(h.items) == 0 {
+		var empty T
+		return empty, fmt.Errorf("heap is empty")
+	}
+	return h.items[0], nil
+}
+
+// PopMax removes and returns the greatest element.
+func (h *MinMaxHeap[T]) PopMax() (T, error) {
+	if len(h.items) == 0 {
+		var empty T
+		return empty, fmt.Errorf("heap is empty")
+	}
+	return h.removeAt(h.maxIndex()), nil
+}
+
+// PopMin removes and returns the smallest element.
+func (h *MinMaxHeap[T]) PopMin() (T, error) {
+	if len(h.items) == 0 {
+		var empty T
+		return empty, fmt.Errorf("heap is empty")
+	}
+	return h.removeAt(0), nil
+}
+
+// Push adds a new element to the heap in O(log n).
+func (h *MinMaxHeap[T]) Push(value T) {
+	h.items = append(h.items, value)
+	h.pushUp(len(h.items) - 1)
+}
+
+// Values returns the elements in heap order, which is not sorted order.
+func (h *MinMaxHeap[T]) Values() []T {
+	return append([]T(nil), h.items...)
+}
+
+// isMinLevel reports whether the index lies on an even level.
+func isMinLevel(i int) bool {
+	return bits.Len(uint(i+1))%2 == 1
+}
+
+// maxIndex returns the index of the greatest element,
+// which is one of the children of the root.
+func (h *MinMaxHeap[T]) maxIndex() int {
+	switch len(h.items) {
+	case 1:
+		return 0
+	case 2:
+		return 1
+	}
+	if h.less(h.items[1], h.items[2]) {
+		return 2
+	}
+	return 1
+}
+
+// removeAt moves the last element into index and restores
+// the ordering below it.
+func (h *MinMaxHeap[T]) removeAt(index int) T {
+	n := len(h.items) - 1
+	value := h.items[index]
+	h.items[index] = h.items[n]
+	var empty T
+	h.items[n] = empty
+	h.items = h.items[:n]
+	if index < n {
+		h.pushDown(index)
+	}
+	return value
+}
+
+// ordered compares two elements either as less (on min levels)
+// or as greater (on max levels).
+func (h *MinMaxHeap[T]) ordered(i, j int, minLevel bool) bool {
+	if minLevel {
+		return h.less(h.items[i], h.items[j])
+	}
+	return h.less(h.items[j], h.items[i])
+}
+
+func (h *MinMaxHeap[T]) pushDown(i int) {
+	minLevel := isMinLevel(i)
+	n := len(h.items)
+	for {
+		// Find the smallest (or greatest) among children and grandchildren
+		m := -1
+		for _, child := range []int{2*i + 1, 2*i + 2, 4*i + 3, 4*i + 4, 4*i + 5, 4*i + 6} {
+			if child < n && (m == -1 || h.ordered(child, m, minLevel)) {
+				m = child
+			}
+		}
+		if m == -1 || !h.ordered(m, i, minLevel) {
+			return
+		}
+
+		h.swap(i, m)
+
+		// A child is on the opposite level and has no descendants
+		// to compare with, so we are done
+		if m <= 2*i+2 {
+			return
+		}
+
+		// A grandchild may now be on the wrong side of its parent
+		parent := (m - 1) / 2
+		if h.ordered(parent, m, minLevel) {
+			h.swap(m, parent)
+		}
+		i = m
+	}
+}
+
+func (h *MinMaxHeap[T]) pushUp(i int) {
+	if i == 0 {
+		return
+	}
+	minLevel := isMinLevel(i)
+	parent := (i - 1) / 2
+
+	// An element on the wrong side of its parent belongs to the
+	// parent's levels, so swap first and continue from there
+	if h.ordered(parent, i, minLevel) {
+		h.swap(i, parent)
+		i = parent
+		minLevel = !minLevel
+	}
+
+	// Move up through the grandparents on the same kind of level
+	for i > 2 {
+		grandParent := ((i-1)/2 - 1) / 2
+		if !h.ordered(i, grandParent, minLevel) {
+			return
+		}
+		h.swap(i, grandParent)
+		i = grandParent
+	}
+}
+
+func (h *MinMaxHeap[T]) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+}
@@ -0,0 +1,122 @@
+package heap
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+type testMinMaxHeap[T any] struct {
+	name  string
+	input []T
+	pops  string
+	want  []T
+}
+
+func TestMinMaxHeap(t *testing.T) {
+	tests := []testMinMaxHeap[int]{
+		{
+			name: "Test pop min only",
+			input: []int{
+				5, 6, 2, 10, 12, 3, 1, 9,
+			},
+			pops: "nnnnnnnn",
+			want: []int{
+				1, 2, 3, 5, 6, 9, 10, 12,
+			},
+		},
+		{
+			name: "Test pop max only",
+			input: []int{
+				5, 6, 2, 10, 12, 3, 1, 9,
+			},
+			pops: "xxxxxxxx",
+			want: []int{
+				12, 10, 9, 6, 5, 3, 2, 1,
+			},
+		},
+		{
+			name: "Test alternating pops",
+			input: []int{
+				5, 6, 2, 10, 12, 3, 1, 9,
+			},
+			pops: "nxnxnxnx",
+			want: []int{
+				1, 12, 2, 10, 3, 9, 5, 6,
+			},
+		},
+		{
+			name: "Test single element",
+			input: []int{
+				7,
+			},
+			pops: "x",
+			want: []int{
+				7,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewMinMaxHeap(tt.input, lessInt)
+			for i, op := range tt.pops {
+				var got int
+				var err error
+				if op == 'n' {
+					got, err = h.PopMin()
+				} else {
+					got, err = h.PopMax()
+				}
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got != tt.want[i] {
+					t.Errorf("actual = %v, want %v", got, tt.want[i])
+				}
+			}
+			if _, err := h.PopMin(); err == nil {
+				t.Errorf("expected error when popping empty heap")
+			}
+			if _, err := h.PeekMax(); err == nil {
+				t.Errorf("expected error when peeking empty heap")
+			}
+		})
+	}
+}
+
+func TestMinMaxHeapRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	h := NewMinMaxHeap(nil, lessInt)
+	var model []int
+	for i := 0; i < 2000; i++ {
+		if len(model) == 0 || r.Intn(3) > 0 {
+			value := r.Intn(100)
+			h.Push(value)
+			model = append(model, value)
+			slices.Sort(model)
+		} else if r.Intn(2) == 0 {
+			got, _ := h.PopMin()
+			if got != model[0] {
+				t.Fatalf("actual min = %v, want %v", got, model[0])
+			}
+			model = model[1:]
+		} else {
+			got, _ := h.PopMax()
+			if got != model[len(model)-1] {
+				t.Fatalf("actual max = %v, want %v", got, model[len(model)-1])
+			}
+			model = model[:len(model)-1]
+		}
+
+		if len(model) > 0 {
+			gotMin, _ := h.PeekMin()
+			gotMax, _ := h.PeekMax()
+			if gotMin != model[0] || gotMax != model[len(model)-1] {
+				t.Fatalf("actual = %v..%v, want %v..%v", gotMin, gotMax, model[0], model[len(model)-1])
+			}
+		}
+		if h.Length() != uint(len(model)) {
+			t.Fatalf("actual length = %v, want length %v", h.Length(), len(model))
+		}
+	}
+}
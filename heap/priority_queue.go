@@ -0,0 +1,189 @@
+package heap
+
+import "fmt"
+
+// Item is a handle to a value stored in a PriorityQueue.
+// It stays valid until the value is popped or removed, and is
+// used to change the priority of the value in O(log n).
+type Item[T any, P any] struct {
+	value    T
+	priority P
+	index    int
+	queue    *PriorityQueue[T, P]
+}
+
+func (item *Item[T, P]) Priority() P {
+	return item.priority
+}
+
+func (item *Item[T, P]) Value() T {
+	return item.value
+}
+
+// PriorityQueue is a heap of values ordered by a separate priority.
+// The value whose priority is less than every other priority,
+// according to less, is popped first.
+type PriorityQueue[T any, P any] struct {
+	items []*Item[T, P]
+	less  func(a, b P) bool
+}
+
+func NewPriorityQueue[T any, P any](less func(a, b P) bool) *PriorityQueue[T, P] {
+	return &PriorityQueue[T, P]{
+		less: less,
+	}
+}
+
+// NewPriorityQueueArray builds a queue from the values and their
+// priorities in O(n) and returns the handle of every value.
+func NewPriorityQueueArray[T any, P any](values []T, priorities []P, less func(a, b P) bool) (*PriorityQueue[T, P], []*Item[T, P]) {
+	pq := NewPriorityQueue[T](less)
+	for i := 0; i < len(values) && i < len(priorities); i++ {
+		pq.items = append(pq.items, &Item[T, P]{
+			value:    values[i],
+			priority: priorities[i],
+			index:    i,
+			queue:    pq,
+		})
+	}
+	items := append([]*Item[T, P](nil), pq.items...)
+	heapify(pq, len(pq.items))
+	return pq, items
+}
+
+// Clear removes all values from the queue and invalidates their handles.
+func (pq *PriorityQueue[T, P]) Clear() {
+	for i, item := range pq.items {
+		item.index = -1
+		item.queue = nil
+		pq.items[i] = nil
+	}
+	pq.items = pq.items[:0]
+}
+
+// Contains reports whether the handle belongs to a value
+// that is still in the queue.
+func (pq *PriorityQueue[T, P]) Contains(item *Item[T, P]) bool {
+	return item != nil && item.queue == pq
+}
+
+func (pq *PriorityQueue[T, P]) IsEmpty() bool {
+	return len(pq.items) == 0
+}
+
+func (pq *PriorityQueue[T, P]) Length() uint {
+	return uint(len(pq.items))
+}
+
+// Peek returns the value with the smallest priority without removing it.
+func (pq *PriorityQueue[T, P]) Peek() (T, P, error) {
+	if len(pq.items) == 0 {
+		var (
+			emptyValue    T
+			emptyPriority P
+		)
+		return emptyValue, emptyPriority, fmt.Errorf("queue is empty")
+	}
+	return pq.items[0].value, pq.items[0].priority, nil
+}
+
+// Pop removes and returns the value with the smallest priority.
+func (pq *PriorityQueue[T, P]) Pop() (T, P, error) {
+	if len(pq.items) == 0 {
+		var (
+			emptyValue    T
+			emptyPriority P
+		)
+		return emptyValue, emptyPriority, fmt.Errorf("queue is empty")
+	}
+	n := len(pq.items) - 1
+	pq.swap(0, n)
+	siftDown(pq, 0, n)
+	item := pq.pop()
+	return item.value, item.priority, nil
+}
+
+// Push adds the value with the given priority and returns its handle.
+func (pq *PriorityQueue[T, P]) Push(value T, priority P) *Item[T, P] {
+	item := &Item[T, P]{
+		value:    value,
+		priority: priority,
+		index:    len(pq.items),
+		queue:    pq,
+	}
+	pq.items = append(pq.items, item)
+	siftUp(pq, item.index)
+	return item
+}
+
+// PushPop pushes the value and then pops the value with the smallest
+// priority, which is the pushed value itself when its priority is
+// not greater than the current top. Use Push followed by Pop when
+// the handle of the pushed value is needed.
+func (pq *PriorityQueue[T, P]) PushPop(value T, priority P) (T, P) {
+	if len(pq.items) == 0 || !pq.less(pq.items[0].priority, priority) {
+		return value, priority
+	}
+
+	// Invalidate the handle of the current top and
+	// put the pushed value in its place
+	top := pq.items[0]
+	top.index = -1
+	top.queue = nil
+	pq.items[0] = &Item[T, P]{
+		value:    value,
+		priority: priority,
+		index:    0,
+		queue:    pq,
+	}
+	siftDown(pq, 0, len(pq.items))
+	return top.value, top.priority
+}
+
+// Remove removes the value of the handle from the queue.
+func (pq *PriorityQueue[T, P]) Remove(item *Item[T, P]) (T, error) {
+	if !pq.Contains(item) {
+		var empty T
+		return empty, fmt.Errorf("item does not belong to this queue")
+	}
+	n := len(pq.items) - 1
+	if n != item.index {
+		index := item.index
+		pq.swap(index, n)
+		fix(pq, index, n)
+	}
+	pq.pop()
+	return item.value, nil
+}
+
+// Update changes the priority of the handle's value and moves it to
+// its new position, which covers both decrease-key and increase-key.
+func (pq *PriorityQueue[T, P]) Update(item *Item[T, P], priority P) error {
+	if !pq.Contains(item) {
+		return fmt.Errorf("item does not belong to this queue")
+	}
+	item.priority = priority
+	fix(pq, item.index, len(pq.items))
+	return nil
+}
+
+func (pq *PriorityQueue[T, P]) lessAt(i, j int) bool {
+	return pq.less(pq.items[i].priority, pq.items[j].priority)
+}
+
+// pop drops the last item and invalidates its handle.
+func (pq *PriorityQueue[T, P]) pop() *Item[T, P] {
+	n := len(pq.items) - 1
+	item := pq.items[n]
+	item.index = -1
+	item.queue = nil
+	pq.items[n] = nil
+	pq.items = pq.items[:n]
+	return item
+}
+
+func (pq *PriorityQueue[T, P]) swap(i, j int) {
+	pq.items[i], pq.items[j] = pq.items[j], pq.items[i]
+	pq.items[i].index = i
+	pq.items[j].index = j
+}
@@ -0,0 +1,128 @@
+package heap
+
+import (
+	"testing"
+)
+
+type testPriorityQueue[T any, P any] struct {
+	name       string
+	values     []T
+	priorities []P
+	update     map[int]P
+	remove     []int
+	wantPop    []T
+}
+
+func TestPriorityQueue(t *testing.T) {
+	tests := []testPriorityQueue[string, int]{
+		{
+			name: "Test pop in priority order",
+			values: []string{
+				"c", "a", "d", "b",
+			},
+			priorities: []int{
+				3, 1, 4, 2,
+			},
+			wantPop: []string{
+				"a", "b", "c", "d",
+			},
+		},
+		{
+			name: "Test decrease and increase key",
+			values: []string{
+				"c", "a", "d", "b",
+			},
+			priorities: []int{
+				3, 1, 4, 2,
+			},
+			update: map[int]int{
+				2: 0,
+				1: 10,
+			},
+			wantPop: []string{
+				"d", "b", "c", "a",
+			},
+		},
+		{
+			name: "Test remove by handle",
+			values: []string{
+				"c", "a", "d", "b",
+			},
+			priorities: []int{
+				3, 1, 4, 2,
+			},
+			remove: []int{
+				1, 2,
+			},
+			wantPop: []string{
+				"b", "c",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pq, items := NewPriorityQueueArray(tt.values, tt.priorities, lessInt)
+			for index, priority := range tt.update {
+				if err := pq.Update(items[index], priority); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			for _, index := range tt.remove {
+				if _, err := pq.Remove(items[index]); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			if pq.Length() != uint(len(tt.wantPop)) {
+				t.Errorf("actual length = %v, want length %v", pq.Length(), len(tt.wantPop))
+			}
+			for _, wantVal := range tt.wantPop {
+				got, _, err := pq.Pop()
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got != wantVal {
+					t.Errorf("actual = %v, want %v", got, wantVal)
+				}
+			}
+		})
+	}
+}
+
+func TestPriorityQueueHandles(t *testing.T) {
+	pq := NewPriorityQueue[string](lessInt)
+	other := NewPriorityQueue[string](lessInt)
+
+	a := pq.Push("a", 5)
+	b := pq.Push("b", 1)
+	if err := other.Update(a, 0); err == nil {
+		t.Errorf("expected error when updating a handle of another queue")
+	}
+
+	value, priority := pq.PushPop("c", 3)
+	if value != "b" || priority != 1 {
+		t.Errorf("actual = %v:%v, want %v:%v", value, priority, "b", 1)
+	}
+	if pq.Contains(b) {
+		t.Errorf("popped handle is still in the queue")
+	}
+	if err := pq.Update(b, 0); err == nil {
+		t.Errorf("expected error when updating a popped handle")
+	}
+
+	if err := pq.Update(a, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	value, priority, _ = pq.Peek()
+	if value != "a" || priority != 0 || a.Priority() != 0 {
+		t.Errorf("actual = %v:%v, want %v:%v", value, priority, "a", 0)
+	}
+
+	pq.Clear()
+	if pq.Contains(a) || !pq.IsEmpty() {
+		t.Errorf("queue is not empty after clear")
+	}
+	if _, _, err := pq.Pop(); err == nil {
+		t.Errorf("expected error when popping empty queue")
+	}
+}
@@ -0,0 +1,64 @@
+package heap
+
+import "slices"
+
+// TopK keeps the k greatest values pushed so far, according to less.
+// It is a min-heap bounded to k values, so the smallest kept value is
+// at the top and is evicted as soon as a greater value arrives.
+type TopK[T any] struct {
+	heap *Heap[T]
+	k    int
+}
+
+func NewTopK[T any](k int, less func(a, b T) bool) *TopK[T] {
+	return &TopK[T]{
+		heap: NewHeap(nil, less),
+		k:    max(k, 0),
+	}
+}
+
+// Clear removes all values
+func (top *TopK[T]) Clear() {
+	top.heap.Clear()
+}
+
+func (top *TopK[T]) IsEmpty() bool {
+	return top.heap.IsEmpty()
+}
+
+func (top *TopK[T]) Length() uint {
+	return top.heap.Length()
+}
+
+// Peek returns the smallest of the kept values, which is the
+// threshold a new value has to exceed to be kept once full.
+func (top *TopK[T]) Peek() (T, error) {
+	return top.heap.Peek()
+}
+
+// Push offers the value to the collection. When the collection is
+// full, it returns the value that did not make it into the top k,
+// which is either an evicted value or the pushed value itself.
+func (top *TopK[T]) Push(value T) (T, bool) {
+	if int(top.heap.Length()) < top.k {
+		top.heap.Push(value)
+		var empty T
+		return empty, false
+	}
+	return top.heap.PushPop(value), true
+}
+
+// Values returns the kept values sorted from greatest to smallest.
+func (top *TopK[T]) Values() []T {
+	values := top.heap.Values()
+	slices.SortFunc(values, func(a, b T) int {
+		if top.heap.less(b, a) {
+			return -1
+		}
+		if top.heap.less(a, b) {
+			return 1
+		}
+		return 0
+	})
+	return values
+}
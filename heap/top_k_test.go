@@ -0,0 +1,82 @@
+package heap
+
+import (
+	"testing"
+)
+
+type testTopK[T any] struct {
+	name        string
+	k           int
+	input       []T
+	wantValues  []T
+	wantEvicted []T
+}
+
+func TestTopK(t *testing.T) {
+	tests := []testTopK[int]{
+		{
+			name: "Test top 3 from several values",
+			k:    3,
+			input: []int{
+				5, 6, 2, 10, 12, 3, 1, 9,
+			},
+			wantValues: []int{
+				12, 10, 9,
+			},
+			wantEvicted: []int{
+				2, 5, 3, 1, 6,
+			},
+		},
+		{
+			name: "Test top k larger than input",
+			k:    10,
+			input: []int{
+				2, 1, 3,
+			},
+			wantValues: []int{
+				3, 2, 1,
+			},
+			wantEvicted: []int{},
+		},
+		{
+			name: "Test top 0 keeps nothing",
+			k:    0,
+			input: []int{
+				2, 1,
+			},
+			wantValues: []int{},
+			wantEvicted: []int{
+				2, 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			top := NewTopK(tt.k, lessInt)
+			evicted := []int{}
+			for _, value := range tt.input {
+				if out, ok := top.Push(value); ok {
+					evicted = append(evicted, out)
+				}
+			}
+
+			got := top.Values()
+			if len(got) != len(tt.wantValues) {
+				t.Fatalf("actual = %v, want %v", got, tt.wantValues)
+			}
+			for i, wantVal := range tt.wantValues {
+				if got[i] != wantVal {
+					t.Errorf("actual = %v, want %v", got[i], wantVal)
+				}
+			}
+			if len(evicted) != len(tt.wantEvicted) {
+				t.Fatalf("actual evicted = %v, want %v", evicted, tt.wantEvicted)
+			}
+			for i, wantVal := range tt.wantEvicted {
+				if evicted[i] != wantVal {
+					t.Errorf("actual evicted = %v, want %v", evicted[i], wantVal)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,419 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// Monoid describes how values are aggregated over a subtree.
+// Combine must be associative and Identity must be its neutral element,
+// i.e. Combine(Identity, v) == Combine(v, Identity) == v.
+// Combine does not need to be commutative: values are always combined
+// in ascending key order.
+type Monoid[V any] struct {
+	Identity V
+	Combine  func(a, b V) V
+}
+
+// AugmentedTree is an AVL tree where every node caches the aggregate of
+// the values in its subtree under a user supplied Monoid. The aggregate is
+// recomputed along the modified path on insert, delete, update and rotation,
+// which allows range aggregates to be answered in O(log n).
+// A root whose TreeNode is nil is an empty tree, which is what is left
+// after the last key has been deleted.
+type AugmentedTree[K cmp.Ordered, V any] struct {
+	*TreeNode[K, V]
+	aggregate V
+	height    int
+	monoid    *Monoid[V]
+	left      *AugmentedTree[K, V]
+	right     *AugmentedTree[K, V]
+}
+
+func NewAugmentedTreeArray[K cmp.Ordered, V any](monoid Monoid[V], keys []K, values []V) *AugmentedTree[K, V] {
+	if len(keys) == 0 {
+		return nil
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	root := NewAugmentedTreeRoot(monoid, keys[0], values[0])
+	for i := 1; i < len(keys); i++ {
+		root.Add(keys[i], values[i])
+	}
+	return root
+}
+
+func NewAugmentedTreeRoot[K cmp.Ordered, V any](monoid Monoid[V], key K, value V) *AugmentedTree[K, V] {
+	return newAugmentedNode(&monoid, key, value)
+}
+
+func newAugmentedNode[K cmp.Ordered, V any](monoid *Monoid[V], key K, value V) *AugmentedTree[K, V] {
+	return &AugmentedTree[K, V]{
+		TreeNode: &TreeNode[K, V]{
+			key:   key,
+			value: value,
+		},
+		aggregate: value,
+		monoid:    monoid,
+	}
+}
+
+// Add a node to the tree and rebalance the path back to the receiver.
+func (tree *AugmentedTree[K, V]) Add(key K, value V) error {
+	err := tree.AddNode(newAugmentedNode(tree.monoid, key, value))
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
+
+func (tree *AugmentedTree[K, V]) AddNode(node *AugmentedTree[K, V]) error {
+	if node == nil {
+		return nil
+	}
+
+	// A root emptied by Delete takes the node as it is
+	if tree.IsEmpty() {
+		*tree = *node
+		return nil
+	}
+
+	var err error
+	if node.key < tree.key {
+		if tree.left == nil {
+			tree.left = node
+		} else {
+			err = tree.left.AddNode(node)
+		}
+	} else if node.key > tree.key {
+		if tree.right == nil {
+			tree.right = node
+		} else {
+			err = tree.right.AddNode(node)
+		}
+	} else {
+		return fmt.Errorf("key already exists")
+	}
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	tree.rebalance()
+	return nil
+}
+
+// Aggregate combines, in ascending key order, the values of every key
+// in the closed range [lo, hi]. It returns the monoid identity when
+// no key falls inside the range.
+func (tree *AugmentedTree[K, V]) Aggregate(lo, hi K) V {
+	if tree.IsEmpty() {
+		if tree == nil || tree.monoid == nil {
+			var empty V
+			return empty
+		}
+		return tree.monoid.Identity
+	}
+	return tree.aggregateRange(tree.monoid, lo, hi)
+}
+
+// aggregateRange descends to the first node inside [lo, hi] and then
+// splits the query into a suffix of its left subtree and a prefix of
+// its right subtree.
+func (tree *AugmentedTree[K, V]) aggregateRange(monoid *Monoid[V], lo, hi K) V {
+	if tree == nil {
+		return monoid.Identity
+	}
+	if tree.key < lo {
+		return tree.right.aggregateRange(monoid, lo, hi)
+	}
+	if tree.key > hi {
+		return tree.left.aggregateRange(monoid, lo, hi)
+	}
+	left := tree.left.aggregateFrom(monoid, lo)
+	right := tree.right.aggregateTo(monoid, hi)
+	return monoid.Combine(monoid.Combine(left, tree.value), right)
+}
+
+// aggregateFrom combines the values of every key greater than or equal to lo.
+func (tree *AugmentedTree[K, V]) aggregateFrom(monoid *Monoid[V], lo K) V {
+	if tree == nil {
+		return monoid.Identity
+	}
+	if tree.key < lo {
+		return tree.right.aggregateFrom(monoid, lo)
+	}
+	left := tree.left.aggregateFrom(monoid, lo)
+	return monoid.Combine(monoid.Combine(left, tree.value), tree.right.total(monoid))
+}
+
+// aggregateTo combines the values of every key less than or equal to hi.
+func (tree *AugmentedTree[K, V]) aggregateTo(monoid *Monoid[V], hi K) V {
+	if tree == nil {
+		return monoid.Identity
+	}
+	if tree.key > hi {
+		return tree.left.aggregateTo(monoid, hi)
+	}
+	right := tree.right.aggregateTo(monoid, hi)
+	return monoid.Combine(monoid.Combine(tree.left.total(monoid), tree.value), right)
+}
+
+func (tree *AugmentedTree[K, V]) Clear() *AugmentedTree[K, V] {
+	if tree == nil {
+		return nil
+	}
+	tree.left = tree.left.Clear()
+	tree.right = tree.right.Clear()
+	tree = nil
+	return tree
+}
+
+// Delete a node from the tree by key.
+// The removed node is replaced by its in-order successor, so the
+// tree only needs to be rebalanced along the path to the receiver.
+func (tree *AugmentedTree[K, V]) Delete(key K) error {
+	if tree.IsEmpty() {
+		return fmt.Errorf("key not found")
+	}
+
+	root, err := deleteAugmented(tree, key)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	// The receiver must stay the root, so copy the new root into it
+	// or mark it as empty when the last key is gone.
+	if root == nil {
+		*tree = AugmentedTree[K, V]{monoid: tree.monoid}
+	} else if root != tree {
+		*tree = *root
+	}
+	return nil
+}
+
+func (tree *AugmentedTree[K, V]) Find(key K) (*AugmentedTree[K, V], error) {
+	if tree.IsEmpty() {
+		return nil, fmt.Errorf("key not found")
+	}
+
+	if tree.key == key {
+		return tree, nil
+	}
+	if key < tree.key {
+		return tree.left.Find(key)
+	} else {
+		return tree.right.Find(key)
+	}
+}
+
+func (tree *AugmentedTree[K, V]) GetBalance() int {
+	return tree.left.Height() - tree.right.Height()
+}
+
+func (tree *AugmentedTree[K, V]) Height() int {
+	if tree.IsEmpty() {
+		return -1
+	}
+	return tree.height
+}
+
+func (tree *AugmentedTree[K, V]) InorderTraversal() []*AugmentedTree[K, V] {
+	if tree.IsEmpty() {
+		return []*AugmentedTree[K, V]{}
+	}
+
+	left := tree.left.InorderTraversal()
+	right := tree.right.InorderTraversal()
+
+	return append(left, append([]*AugmentedTree[K, V]{tree}, right...)...)
+}
+
+func (tree *AugmentedTree[K, V]) IsEmpty() bool {
+	return tree == nil || tree.TreeNode == nil
+}
+
+// Key returns the key of the node and false if the tree is empty.
+func (tree *AugmentedTree[K, V]) Key() (K, bool) {
+	if tree.IsEmpty() {
+		var empty K
+		return empty, false
+	}
+	return tree.key, true
+}
+
+// RotateLeft rotates the subtree to the left while keeping the receiver
+// as the subtree root, and refreshes the cached height and aggregate.
+func (tree *AugmentedTree[K, V]) RotateLeft() {
+	if tree.right == nil {
+		return
+	}
+
+	// Detach the pivot's left subtree and hang it under the old root
+	pivot := tree.right
+	tree.right = pivot.left
+	tree.update()
+
+	// Swap contents so the receiver holds the pivot and the
+	// pivot's allocation holds the old root
+	*tree, *pivot = *pivot, *tree
+	tree.left = pivot
+	tree.update()
+}
+
+// RotateRight rotates the subtree to the right while keeping the receiver
+// as the subtree root, and refreshes the cached height and aggregate.
+func (tree *AugmentedTree[K, V]) RotateRight() {
+	if tree.left == nil {
+		return
+	}
+
+	// Detach the pivot's right subtree and hang it under the old root
+	pivot := tree.left
+	tree.left = pivot.right
+	tree.update()
+
+	// Swap contents so the receiver holds the pivot and the
+	// pivot's allocation holds the old root
+	*tree, *pivot = *pivot, *tree
+	tree.right = pivot
+	tree.update()
+}
+
+// SetValue replaces the value of the node in place and refreshes its
+// aggregate. It fails if the tree is empty, as there is no node to hold
+// the value. The aggregates above the node are not refreshed, so use
+// Update on the root to change a value below it.
+func (tree *AugmentedTree[K, V]) SetValue(value V) error {
+	if tree.IsEmpty() {
+		return fmt.Errorf("tree is empty")
+	}
+	tree.value = value
+	tree.update()
+	return nil
+}
+
+// Total returns the aggregate of every value in the subtree.
+func (tree *AugmentedTree[K, V]) Total() V {
+	if tree.IsEmpty() {
+		if tree == nil || tree.monoid == nil {
+			var empty V
+			return empty
+		}
+		return tree.monoid.Identity
+	}
+	return tree.aggregate
+}
+
+// Update replaces the value stored under key and refreshes
+// the aggregates on the path from the receiver to the key.
+func (tree *AugmentedTree[K, V]) Update(key K, value V) error {
+	if tree.IsEmpty() {
+		return fmt.Errorf("key not found")
+	}
+
+	var err error
+	if key < tree.key {
+		err = tree.left.Update(key, value)
+	} else if key > tree.key {
+		err = tree.right.Update(key, value)
+	} else {
+		tree.TreeNode = &TreeNode[K, V]{
+			key:   key,
+			value: value,
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	tree.update()
+	return nil
+}
+
+// Value returns the value of the node and false if the tree is empty.
+func (tree *AugmentedTree[K, V]) Value() (V, bool) {
+	if tree.IsEmpty() {
+		var empty V
+		return empty, false
+	}
+	return tree.value, true
+}
+
+// rebalance refreshes the node's augmentation and performs
+// the AVL rotations needed to bring its balance back to [-1, 1].
+func (tree *AugmentedTree[K, V]) rebalance() {
+	tree.update()
+
+	if tree.GetBalance() > 1 {
+		if tree.left.GetBalance() < 0 {
+			tree.left.RotateLeft()
+		}
+		tree.RotateRight()
+	}
+
+	if tree.GetBalance() < -1 {
+		if tree.right.GetBalance() > 0 {
+			tree.right.RotateRight()
+		}
+		tree.RotateLeft()
+	}
+}
+
+// update recomputes the cached height and aggregate from the children.
+func (tree *AugmentedTree[K, V]) update() {
+	tree.height = 1 + max(tree.left.Height(), tree.right.Height())
+	tree.aggregate = tree.monoid.Combine(
+		tree.monoid.Combine(tree.left.total(tree.monoid), tree.value),
+		tree.right.total(tree.monoid),
+	)
+}
+
+// total is Total for a subtree that may be nil, falling back
+// to the identity of the given monoid.
+func (tree *AugmentedTree[K, V]) total(monoid *Monoid[V]) V {
+	if tree.IsEmpty() {
+		return monoid.Identity
+	}
+	return tree.aggregate
+}
+
+func deleteAugmented[K cmp.Ordered, V any](tree *AugmentedTree[K, V], key K) (*AugmentedTree[K, V], error) {
+	var err error
+	// If the node is not found, return an error
+	if tree == nil {
+		return nil, fmt.Errorf("key not found")
+	}
+
+	if key < tree.key {
+		tree.left, err = deleteAugmented(tree.left, key)
+		if err != nil {
+			return tree, fmt.Errorf("%w", err)
+		}
+	} else if key > tree.key {
+		tree.right, err = deleteAugmented(tree.right, key)
+		if err != nil {
+			return tree, fmt.Errorf("%w", err)
+		}
+	} else {
+		// A node with at most one child is replaced by that child
+		if tree.left == nil {
+			return tree.right, nil
+		}
+		if tree.right == nil {
+			return tree.left, nil
+		}
+
+		// Otherwise take over the in-order successor's key and value
+		// and remove the successor from the right subtree
+		successor := tree.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		tree.TreeNode = successor.TreeNode
+		tree.right, _ = deleteAugmented(tree.right, successor.key)
+	}
+
+	tree.rebalance()
+	return tree, nil
+}
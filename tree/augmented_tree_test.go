@@ -0,0 +1,239 @@
+package tree
+
+import (
+	"cmp"
+	"math"
+	"testing"
+)
+
+type testAugmentedTree[K cmp.Ordered, V any] struct {
+	name      string
+	inputKeys []K
+	inputVals []V
+	deleteKey []K
+	lo        K
+	hi        K
+	want      V
+}
+
+func TestAugmentedTreeSum(t *testing.T) {
+	sum := Monoid[int]{
+		Identity: 0,
+		Combine:  func(a, b int) int { return a + b },
+	}
+	tests := []testAugmentedTree[int, int]{
+		{
+			name: "Test sum: whole range",
+			inputKeys: []int{
+				5, 6, 2, 10, 12, 3, 1, 9,
+			},
+			inputVals: []int{
+				5, 6, 2, 10, 12, 3, 1, 9,
+			},
+			lo:   0,
+			hi:   100,
+			want: 48,
+		},
+		{
+			name: "Test sum: inner range",
+			inputKeys: []int{
+				5, 6, 2, 10, 12, 3, 1, 9,
+			},
+			inputVals: []int{
+				5, 6, 2, 10, 12, 3, 1, 9,
+			},
+			lo:   3,
+			hi:   9,
+			want: 23,
+		},
+		{
+			name: "Test sum: range without keys",
+			inputKeys: []int{
+				5, 6, 2, 10, 12, 3, 1, 9,
+			},
+			inputVals: []int{
+				5, 6, 2, 10, 12, 3, 1, 9,
+			},
+			lo:   7,
+			hi:   8,
+			want: 0,
+		},
+		{
+			name: "Test sum: after deleting keys",
+			inputKeys: []int{
+				5, 6, 2, 10, 12, 3, 1, 9,
+			},
+			inputVals: []int{
+				5, 6, 2, 10, 12, 3, 1, 9,
+			},
+			deleteKey: []int{
+				5, 10,
+			},
+			lo:   2,
+			hi:   10,
+			want: 20,
+		},
+		{
+			name: "Test sum: after deleting every key",
+			inputKeys: []int{
+				1, 2,
+			},
+			inputVals: []int{
+				1, 2,
+			},
+			deleteKey: []int{
+				1, 2,
+			},
+			lo:   0,
+			hi:   10,
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := NewAugmentedTreeArray(sum, tt.inputKeys, tt.inputVals)
+			for _, key := range tt.deleteKey {
+				if err := root.Delete(key); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			got := root.Aggregate(tt.lo, tt.hi)
+			if got != tt.want {
+				t.Errorf("actual = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAugmentedTreeMin(t *testing.T) {
+	minimum := Monoid[int]{
+		Identity: math.MaxInt,
+		Combine:  func(a, b int) int { return min(a, b) },
+	}
+	tests := []testAugmentedTree[string, int]{
+		{
+			name: "Test min: range by string key",
+			inputKeys: []string{
+				"d", "a", "c", "b", "e",
+			},
+			inputVals: []int{
+				4, 1, 3, 7, 0,
+			},
+			lo:   "b",
+			hi:   "d",
+			want: 3,
+		},
+		{
+			name: "Test min: empty range returns identity",
+			inputKeys: []string{
+				"d", "a", "c", "b", "e",
+			},
+			inputVals: []int{
+				4, 1, 3, 7, 0,
+			},
+			lo:   "x",
+			hi:   "z",
+			want: math.MaxInt,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := NewAugmentedTreeArray(minimum, tt.inputKeys, tt.inputVals)
+
+			got := root.Aggregate(tt.lo, tt.hi)
+			if got != tt.want {
+				t.Errorf("actual = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAugmentedTreeOrderedCombine(t *testing.T) {
+	// String concatenation is not commutative, so the aggregate
+	// reveals whether values are combined in key order.
+	concat := Monoid[string]{
+		Identity: "",
+		Combine:  func(a, b string) string { return a + b },
+	}
+	root := NewAugmentedTreeRoot(concat, 0, "a")
+	letters := "abcdefghijklmnopqrstuvwxyz"
+	for i := 1; i < len(letters); i++ {
+		_ = root.Add(i, letters[i:i+1])
+	}
+	_ = root.Update(3, "D")
+
+	for lo := 0; lo < len(letters); lo++ {
+		for hi := lo; hi < len(letters); hi++ {
+			want := letters[lo : hi+1]
+			if lo <= 3 && 3 <= hi {
+				want = letters[lo:3] + "D" + letters[4:hi+1]
+			}
+			if got := root.Aggregate(lo, hi); got != want {
+				t.Errorf("Aggregate(%v, %v) = %v, want %v", lo, hi, got, want)
+			}
+		}
+	}
+	if root.Height() > 5 {
+		t.Errorf("tree height %v is too large", root.Height())
+	}
+}
+
+func TestAugmentedTreeAddNodeAfterDeletingAll(t *testing.T) {
+	sum := Monoid[int]{
+		Identity: 0,
+		Combine:  func(a, b int) int { return a + b },
+	}
+	root := NewAugmentedTreeArray(sum, []int{1, 2}, []int{1, 2})
+	for _, key := range []int{1, 2} {
+		if err := root.Delete(key); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if err := root.AddNode(NewAugmentedTreeRoot(sum, 5, 5)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := root.Add(7, 7); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := root.Total(); got != 12 {
+		t.Errorf("actual = %v, want %v", got, 12)
+	}
+}
+
+func TestAugmentedTreeAccessors(t *testing.T) {
+	sum := Monoid[int]{
+		Identity: 0,
+		Combine:  func(a, b int) int { return a + b },
+	}
+	root := NewAugmentedTreeArray(sum, []int{2, 1, 3}, []int{2, 1, 3})
+	if key, ok := root.Key(); !ok || key != 2 {
+		t.Errorf("actual = %v, %v, want %v, %v", key, ok, 2, true)
+	}
+	if err := root.SetValue(20); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value, ok := root.Value(); !ok || value != 20 {
+		t.Errorf("actual = %v, %v, want %v, %v", value, ok, 20, true)
+	}
+	if got := root.Total(); got != 24 {
+		t.Errorf("actual total = %v, want %v", got, 24)
+	}
+
+	// An emptied root has no key or value to access
+	for _, key := range []int{1, 2, 3} {
+		if err := root.Delete(key); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, ok := root.Key(); ok {
+		t.Errorf("expected no key in an empty tree")
+	}
+	if _, ok := root.Value(); ok {
+		t.Errorf("expected no value in an empty tree")
+	}
+	if err := root.SetValue(4); err == nil {
+		t.Errorf("expected error when setting the value of an empty tree")
+	}
+}
@@ -459,7 +459,10 @@ func TestAVLTreeDeleteNode(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Add root
 			root := NewAVLTArray[int](tt.inputKeys, tt.inputVals)
-			err := root.Delete(tt.inputDeleteKey)
+			value, err := root.Delete(tt.inputDeleteKey)
+			if !tt.wantError && value != tt.inputDeleteKey {
+				t.Errorf("actual removed value = %v, want %v", value, tt.inputDeleteKey)
+			}
 
 			got := root.LevelOrderTraversal()
 			if len(got) != len(tt.wantTreeVal) {
@@ -479,6 +482,34 @@ func TestAVLTreeDeleteNode(t *testing.T) {
 	}
 }
 
+func TestAVLTreeDeleteLastNode(t *testing.T) {
+	root := NewAVLTRoot(1, "one")
+	value, err := root.Delete(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value != "one" {
+		t.Errorf("actual removed value = %v, want %v", value, "one")
+	}
+	if !root.IsEmpty() {
+		t.Errorf("tree is not empty after deleting the last key")
+	}
+	if _, err := root.Delete(1); err == nil {
+		t.Errorf("expected error when deleting from an empty tree")
+	}
+	if _, err := root.Find(1); err == nil {
+		t.Errorf("expected error when finding in an empty tree")
+	}
+
+	// The emptied root is reused by the next Add
+	_ = root.Add(2, "two")
+	_ = root.Add(3, "three")
+	got := root.InorderTraversal()
+	if len(got) != 2 || got[0].key != 2 || got[1].key != 3 {
+		t.Errorf("unexpected tree after adding to an emptied root")
+	}
+}
+
 type testAVLTreeClear[K cmp.Ordered, V any] struct {
 	name        string
 	inputKeys   []K
@@ -609,3 +640,57 @@ func TestAVLTreeUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestAVLTreeAccessors(t *testing.T) {
+	root := NewAVLTArray([]int{2, 1, 3, 4}, []string{"b", "a", "c", "d"})
+	key, _ := root.Key()
+	value, _ := root.Value()
+	if key != 2 || value != "b" {
+		t.Errorf("actual = %v:%v, want %v:%v", key, value, 2, "b")
+	}
+	left, _ := root.Left().Key()
+	right, _ := root.Right().Key()
+	rightRight, _ := root.Right().Right().Key()
+	if left != 1 || right != 3 || rightRight != 4 {
+		t.Errorf("unexpected children of the root")
+	}
+	if _, ok := root.Left().Left().Key(); ok {
+		t.Errorf("expected no key below a leaf")
+	}
+	if root.Left().Left() != nil || root.Left().Right() != nil {
+		t.Errorf("leaf has children")
+	}
+	if root.Height() != 2 || root.Size() != 4 {
+		t.Errorf("actual height, size = %v, %v, want %v, %v", root.Height(), root.Size(), 2, 4)
+	}
+
+	node, _ := root.Find(3)
+	if err := node.SetValue("C"); err != nil {
+		t.Fatalf("SetValue() error = %v", err)
+	}
+	if value, ok := root.Lookup(3); !ok || value != "C" {
+		t.Errorf("actual = %v, %v, want %v, %v", value, ok, "C", true)
+	}
+	if _, ok := root.Lookup(9); ok {
+		t.Errorf("expected no value for a missing key")
+	}
+
+	// An emptied root has no size and no children
+	empty := NewAVLTRoot(1, "a")
+	_, _ = empty.Delete(1)
+	if empty.Size() != 0 || empty.Height() != -1 || empty.Left() != nil || empty.Right() != nil {
+		t.Errorf("unexpected accessors of an empty tree")
+	}
+	if _, ok := empty.Lookup(1); ok {
+		t.Errorf("expected no value in an empty tree")
+	}
+	if _, ok := empty.Key(); ok {
+		t.Errorf("expected no key in an empty tree")
+	}
+	if _, ok := empty.Value(); ok {
+		t.Errorf("expected no value at the root of an empty tree")
+	}
+	if err := empty.SetValue("b"); err == nil {
+		t.Errorf("expected error when setting the value of an empty tree")
+	}
+}
@@ -3,6 +3,9 @@ package tree
 import (
 	"cmp"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 
 	"github.com/dukenmarga/gollection/deque"
 )
@@ -61,18 +64,30 @@ func (tree *BinarySearchTree[K, V]) AddNode(node *BinarySearchTree[K, V]) error
 		return nil
 	}
 
+	// A root emptied by Delete takes the node as it is
+	if tree.IsEmpty() {
+		*tree = *node
+		return nil
+	}
+
 	if node.key < tree.key {
 		if tree.left == nil {
 			tree.left = node
 			return nil
 		}
-		tree.left.AddNode(node)
+		err := tree.left.AddNode(node)
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
 	} else if node.key > tree.key {
 		if tree.right == nil {
 			tree.right = node
 			return nil
 		}
-		tree.right.AddNode(node)
+		err := tree.right.AddNode(node)
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
 	} else if node.key == tree.key {
 		return fmt.Errorf("key already exists")
 	} else {
@@ -92,79 +107,124 @@ func (tree *BinarySearchTree[K, V]) Clear() *BinarySearchTree[K, V] {
 }
 
 func (tree *BinarySearchTree[K, V]) DebugInorderTraversalAsList() {
-	fmt.Printf("Inorder Traversal:\n")
+	_ = tree.DebugInorderTraversalAsListTo(os.Stdout)
+}
+
+// DebugInorderTraversalAsListTo writes the nodes of the
+// inorder traversal to w, in the same format
+// as DebugInorderTraversalAsList.
+func (tree *BinarySearchTree[K, V]) DebugInorderTraversalAsListTo(w io.Writer) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Inorder Traversal:\n")
 	list := tree.InorderTraversal()
 	for _, node := range list {
-		fmt.Printf("%+v", node.TreeNode)
-		fmt.Printf("\n")
+		fmt.Fprintf(&sb, "%+v", node.TreeNode)
+		fmt.Fprintf(&sb, "\n")
 	}
-	fmt.Printf("\n")
+	fmt.Fprintf(&sb, "\n")
+
+	_, err := io.WriteString(w, sb.String())
+	return err
 }
 
 func (tree *BinarySearchTree[K, V]) DebugLevelOrderTraversalAsList() {
-	fmt.Printf("Level Order Traversal:\n")
+	_ = tree.DebugLevelOrderTraversalAsListTo(os.Stdout)
+}
+
+// DebugLevelOrderTraversalAsListTo writes the nodes of the
+// level order traversal to w, in the same format
+// as DebugLevelOrderTraversalAsList.
+func (tree *BinarySearchTree[K, V]) DebugLevelOrderTraversalAsListTo(w io.Writer) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Level Order Traversal:\n")
 	list := tree.LevelOrderTraversal()
 	for _, node := range list {
-		fmt.Printf("%+v", node.TreeNode)
-		fmt.Printf("\n")
+		fmt.Fprintf(&sb, "%+v", node.TreeNode)
+		fmt.Fprintf(&sb, "\n")
 	}
-	fmt.Printf("\n")
+	fmt.Fprintf(&sb, "\n")
+
+	_, err := io.WriteString(w, sb.String())
+	return err
 }
 
-func (tree *BinarySearchTree[K, V]) Delete(key K) error {
-	var err error
-	_, err = deleteBSTNode(tree, key)
+// Delete a node from the tree by key and return its value.
+// A node with two children takes over the key and value of its
+// in-order successor, which is then removed instead.
+// Deleting the last key leaves the root empty, see IsEmpty.
+func (tree *BinarySearchTree[K, V]) Delete(key K) (V, error) {
+	if tree.IsEmpty() {
+		var empty V
+		return empty, fmt.Errorf("key not found")
+	}
+
+	root, value, err := deleteBSTNode(tree, key)
 	if err != nil {
-		return fmt.Errorf("%w", err)
+		return value, fmt.Errorf("%w", err)
 	}
-	return nil
+
+	// The receiver must stay the root, so copy
+	// the child that replaced it into it
+	if root == nil {
+		*tree = BinarySearchTree[K, V]{}
+	} else if root != tree {
+		*tree = *root
+	}
+	return value, nil
 }
 
-// Delete a node from the tree by key.
-// After deleting the node, the parent node
-// will re-add the node's children.
-func deleteBSTNode[K cmp.Ordered, V any](tree *BinarySearchTree[K, V], key K) (*BinarySearchTree[K, V], error) {
-	var err error
+// deleteBSTNode removes the key from the subtree and returns
+// the new root of the subtree together with the removed value.
+func deleteBSTNode[K cmp.Ordered, V any](tree *BinarySearchTree[K, V], key K) (*BinarySearchTree[K, V], V, error) {
+	var (
+		err   error
+		value V
+	)
 	// If the node is not found, return an error
 	if tree == nil {
-		return nil, fmt.Errorf("key not found")
+		return nil, value, fmt.Errorf("key not found")
 	}
 
 	if key < tree.key {
-		tree.left, err = deleteBSTNode(tree.left, key)
+		tree.left, value, err = deleteBSTNode(tree.left, key)
 		if err != nil {
-			return tree, fmt.Errorf("%w", err)
+			return tree, value, fmt.Errorf("%w", err)
 		}
 	} else if key > tree.key {
-		tree.right, err = deleteBSTNode(tree.right, key)
+		tree.right, value, err = deleteBSTNode(tree.right, key)
 		if err != nil {
-			return tree, fmt.Errorf("%w", err)
+			return tree, value, fmt.Errorf("%w", err)
 		}
 	} else if key == tree.key {
-		// Set the tree.left as the new node
-		// replacing the deleted node.
-		// Then add the right child of the deleted node
-		// by calling AddNode, so it can re-determine
-		// the position of the node under the new parent.
-		if tree.left != nil {
-			right := tree.right
-			*tree = *tree.left
-			_ = tree.AddNode(right)
-		} else {
-			if tree.right != nil {
-				*tree = *tree.right
-			}
+		value = tree.value
+
+		// A node with at most one child is replaced by that child
+		if tree.left == nil {
+			return tree.right, value, nil
 		}
-		if tree.left == nil && tree.right == nil {
-			return nil, nil
+		if tree.right == nil {
+			return tree.left, value, nil
 		}
+
+		// Otherwise take over the in-order successor's key and value
+		// and remove the successor from the right subtree
+		successor := tree.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		tree.TreeNode = successor.TreeNode
+		tree.right, _, _ = deleteBSTNode(tree.right, successor.key)
 	}
 
-	return tree, nil
+	return tree, value, nil
 }
 
+// Find returns the node holding the key. Delete can move an entry into
+// another node, either the successor's entry into a node with two
+// children or the only child of the root into the root, so the node is
+// only valid until the next Delete. Use Lookup to get the value only.
 func (tree *BinarySearchTree[K, V]) Find(key K) (*BinarySearchTree[K, V], error) {
-	if tree == nil {
+	if tree.IsEmpty() {
 		return nil, fmt.Errorf("key not found")
 	}
 
@@ -178,8 +238,17 @@ func (tree *BinarySearchTree[K, V]) Find(key K) (*BinarySearchTree[K, V], error)
 	}
 }
 
+// Height is the number of edges on the longest path down to a leaf,
+// -1 for an empty tree, like AVLTree.Height.
+func (tree *BinarySearchTree[K, V]) Height() int {
+	if tree.IsEmpty() {
+		return -1
+	}
+	return 1 + max(tree.left.Height(), tree.right.Height())
+}
+
 func (tree *BinarySearchTree[K, V]) InorderTraversal() []*BinarySearchTree[K, V] {
-	if tree == nil {
+	if tree.IsEmpty() {
 		return []*BinarySearchTree[K, V]{}
 	}
 
@@ -189,8 +258,31 @@ func (tree *BinarySearchTree[K, V]) InorderTraversal() []*BinarySearchTree[K, V]
 	return append(left, append([]*BinarySearchTree[K, V]{tree}, right...)...)
 }
 
-func (tree *BinarySearchTree[K, V]) LevelOrderTraversal() []*BinarySearchTree[K, V] {
+// IsEmpty reports whether the tree has no keys, which is
+// the case for a nil tree or a root whose last key was deleted.
+func (tree *BinarySearchTree[K, V]) IsEmpty() bool {
+	return tree == nil || tree.TreeNode == nil
+}
+
+// Key returns the key of the node and false if the tree is empty.
+func (tree *BinarySearchTree[K, V]) Key() (K, bool) {
+	if tree.IsEmpty() {
+		var empty K
+		return empty, false
+	}
+	return tree.key, true
+}
+
+// Left returns the left child, or nil if there is none.
+func (tree *BinarySearchTree[K, V]) Left() *BinarySearchTree[K, V] {
 	if tree == nil {
+		return nil
+	}
+	return tree.left
+}
+
+func (tree *BinarySearchTree[K, V]) LevelOrderTraversal() []*BinarySearchTree[K, V] {
+	if tree.IsEmpty() {
 		return []*BinarySearchTree[K, V]{}
 	}
 
@@ -212,6 +304,42 @@ func (tree *BinarySearchTree[K, V]) LevelOrderTraversal() []*BinarySearchTree[K,
 	return results
 }
 
+// Lookup returns the value of the key and whether the key was found.
+func (tree *BinarySearchTree[K, V]) Lookup(key K) (V, bool) {
+	node, err := tree.Find(key)
+	if err != nil {
+		var empty V
+		return empty, false
+	}
+	return node.value, true
+}
+
+// Right returns the right child, or nil if there is none.
+func (tree *BinarySearchTree[K, V]) Right() *BinarySearchTree[K, V] {
+	if tree == nil {
+		return nil
+	}
+	return tree.right
+}
+
+// SetValue replaces the value of the node in place.
+// It fails if the tree is empty, as there is no node to hold the value.
+func (tree *BinarySearchTree[K, V]) SetValue(value V) error {
+	if tree.IsEmpty() {
+		return fmt.Errorf("tree is empty")
+	}
+	tree.TreeNode.SetValue(value)
+	return nil
+}
+
+// Size returns the number of keys, counting the nodes in O(n).
+func (tree *BinarySearchTree[K, V]) Size() int {
+	if tree.IsEmpty() {
+		return 0
+	}
+	return 1 + tree.left.Size() + tree.right.Size()
+}
+
 func (tree *BinarySearchTree[K, V]) Update(key K, value V) error {
 	node, err := tree.Find(key)
 	if err != nil {
@@ -227,3 +355,12 @@ func (tree *BinarySearchTree[K, V]) Update(key K, value V) error {
 	}
 	return nil
 }
+
+// Value returns the value of the node and false if the tree is empty.
+func (tree *BinarySearchTree[K, V]) Value() (V, bool) {
+	if tree.IsEmpty() {
+		var empty V
+		return empty, false
+	}
+	return tree.value, true
+}
@@ -0,0 +1,144 @@
+package tree
+
+import (
+	"cmp"
+	"iter"
+)
+
+// Clone returns a copy of the tree with the same shape and new nodes.
+// Every value is copied with cloneValue, or assigned if it is nil.
+// Cloning an empty tree returns an empty root.
+func (tree *AVLTree[K, V]) Clone(cloneValue func(V) V) *AVLTree[K, V] {
+	if tree.IsEmpty() {
+		return &AVLTree[K, V]{}
+	}
+	return tree.clone(cloneValue)
+}
+
+func (tree *AVLTree[K, V]) clone(cloneValue func(V) V) *AVLTree[K, V] {
+	if tree == nil {
+		return nil
+	}
+	return &AVLTree[K, V]{
+		TreeNode: tree.TreeNode.clone(cloneValue),
+		left:     tree.left.clone(cloneValue),
+		right:    tree.right.clone(cloneValue),
+	}
+}
+
+// Compare compares the entries of both trees in key order, regardless
+// of their shape, like slices.CompareFunc. Keys are compared first and
+// values with cmp, and the first non-zero result is returned. If the
+// entries of one tree are a prefix of the other, it is the smaller one.
+func (tree *AVLTree[K, V]) Compare(other *AVLTree[K, V], cmp func(a, b V) int) int {
+	return compareEntries(inorderSeq(tree.walkInorder), inorderSeq(other.walkInorder), cmp)
+}
+
+// Equal reports whether both trees hold the same keys, and eq returns
+// true for the values of every key, regardless of the shape of the trees.
+func (tree *AVLTree[K, V]) Equal(other *AVLTree[K, V], eq func(a, b V) bool) bool {
+	return equalEntries(inorderSeq(tree.walkInorder), inorderSeq(other.walkInorder), eq)
+}
+
+// Clone returns a copy of the tree with the same shape and new nodes.
+// Every value is copied with cloneValue, or assigned if it is nil.
+// Cloning an empty tree returns an empty root.
+func (tree *BinarySearchTree[K, V]) Clone(cloneValue func(V) V) *BinarySearchTree[K, V] {
+	if tree.IsEmpty() {
+		return &BinarySearchTree[K, V]{}
+	}
+	return tree.clone(cloneValue)
+}
+
+func (tree *BinarySearchTree[K, V]) clone(cloneValue func(V) V) *BinarySearchTree[K, V] {
+	if tree == nil {
+		return nil
+	}
+	return &BinarySearchTree[K, V]{
+		TreeNode: tree.TreeNode.clone(cloneValue),
+		left:     tree.left.clone(cloneValue),
+		right:    tree.right.clone(cloneValue),
+	}
+}
+
+// Compare compares the entries of both trees in key order, regardless
+// of their shape, like slices.CompareFunc. Keys are compared first and
+// values with cmp, and the first non-zero result is returned. If the
+// entries of one tree are a prefix of the other, it is the smaller one.
+func (tree *BinarySearchTree[K, V]) Compare(other *BinarySearchTree[K, V], cmp func(a, b V) int) int {
+	return compareEntries(inorderSeq(tree.walkInorder), inorderSeq(other.walkInorder), cmp)
+}
+
+// Equal reports whether both trees hold the same keys, and eq returns
+// true for the values of every key, regardless of the shape of the trees.
+func (tree *BinarySearchTree[K, V]) Equal(other *BinarySearchTree[K, V], eq func(a, b V) bool) bool {
+	return equalEntries(inorderSeq(tree.walkInorder), inorderSeq(other.walkInorder), eq)
+}
+
+func (node *TreeNode[K, V]) clone(cloneValue func(V) V) *TreeNode[K, V] {
+	value := node.value
+	if cloneValue != nil {
+		value = cloneValue(value)
+	}
+	return &TreeNode[K, V]{
+		key:   node.key,
+		value: value,
+	}
+}
+
+// inorderSeq turns the walkInorder of a tree into an iterator
+// over its entries.
+func inorderSeq[K cmp.Ordered, V any, N validateNode[K, V, N]](walk func(func(N) bool) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		walk(func(node N) bool {
+			entry := node.treeNode()
+			return yield(entry.key, entry.value)
+		})
+	}
+}
+
+// compareEntries walks both sequences side by side,
+// so neither tree has to be collected first.
+func compareEntries[K cmp.Ordered, V any](a, b iter.Seq2[K, V], compareValue func(a, b V) int) int {
+	nextA, stopA := iter.Pull2(a)
+	defer stopA()
+	nextB, stopB := iter.Pull2(b)
+	defer stopB()
+
+	for {
+		keyA, valueA, okA := nextA()
+		keyB, valueB, okB := nextB()
+		switch {
+		case !okA && !okB:
+			return 0
+		case !okA:
+			return -1
+		case !okB:
+			return 1
+		}
+		if c := cmp.Compare(keyA, keyB); c != 0 {
+			return c
+		}
+		if c := compareValue(valueA, valueB); c != 0 {
+			return c
+		}
+	}
+}
+
+func equalEntries[K cmp.Ordered, V any](a, b iter.Seq2[K, V], eq func(a, b V) bool) bool {
+	nextA, stopA := iter.Pull2(a)
+	defer stopA()
+	nextB, stopB := iter.Pull2(b)
+	defer stopB()
+
+	for {
+		keyA, valueA, okA := nextA()
+		keyB, valueB, okB := nextB()
+		if !okA || !okB {
+			return okA == okB
+		}
+		if keyA != keyB || !eq(valueA, valueB) {
+			return false
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package tree
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestTreeClone(t *testing.T) {
+	keys := []int{5, 6, 2, 10, 12, 3, 1, 9}
+	values := make([][]int, len(keys))
+	for i, key := range keys {
+		values[i] = []int{key}
+	}
+
+	avl := NewAVLTArray(keys, values)
+	bst := NewBSTArray(keys, values)
+	avlClone := avl.Clone(slices.Clone[[]int])
+	bstClone := bst.Clone(nil)
+
+	// The clones keep the shape
+	for i, node := range avl.LevelOrderTraversal() {
+		if avlClone.LevelOrderTraversal()[i].key != node.key {
+			t.Fatalf("AVL clone has a different shape")
+		}
+	}
+	for i, node := range bst.LevelOrderTraversal() {
+		if bstClone.LevelOrderTraversal()[i].key != node.key {
+			t.Fatalf("BST clone has a different shape")
+		}
+	}
+
+	// but not the nodes
+	_ = avl.Add(100, []int{100})
+	_, _ = bst.Delete(5)
+	avl.InorderTraversal()[0].value[0] = -1
+	bst.InorderTraversal()[0].value[0] = -1
+	if _, err := avlClone.Find(100); err == nil {
+		t.Errorf("key added to the tree is in the AVL clone")
+	}
+	if _, err := bstClone.Find(5); err != nil {
+		t.Errorf("key deleted from the tree is not in the BST clone")
+	}
+	if got := avlClone.InorderTraversal()[0].value[0]; got != 1 {
+		t.Errorf("deep clone: actual = %v, want %v", got, 1)
+	}
+	if got := bstClone.InorderTraversal()[0].value[0]; got != -1 {
+		t.Errorf("shallow clone: actual = %v, want %v", got, -1)
+	}
+	if err := avlClone.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	var empty *AVLTree[int, []int]
+	if clone := empty.Clone(nil); !clone.IsEmpty() {
+		t.Errorf("clone of an empty tree is not empty")
+	}
+}
+
+type testTreeCompare[K cmp.Ordered, V any] struct {
+	name        string
+	inputKeys   []K
+	inputVals   []V
+	otherKeys   []K
+	otherVals   []V
+	wantEqual   bool
+	wantCompare int
+}
+
+func TestTreeEqualAndCompare(t *testing.T) {
+	tests := []testTreeCompare[int, int]{
+		{
+			name:        "Test compare: same entries in a different shape",
+			inputKeys:   []int{1, 2, 3, 4, 5},
+			inputVals:   []int{1, 2, 3, 4, 5},
+			otherKeys:   []int{5, 4, 3, 2, 1},
+			otherVals:   []int{5, 4, 3, 2, 1},
+			wantEqual:   true,
+			wantCompare: 0,
+		},
+		{
+			name:        "Test compare: different value",
+			inputKeys:   []int{1, 2, 3},
+			inputVals:   []int{1, 2, 3},
+			otherKeys:   []int{1, 2, 3},
+			otherVals:   []int{1, 20, 3},
+			wantEqual:   false,
+			wantCompare: -1,
+		},
+		{
+			name:        "Test compare: different key",
+			inputKeys:   []int{1, 4},
+			inputVals:   []int{1, 0},
+			otherKeys:   []int{1, 3},
+			otherVals:   []int{1, 9},
+			wantEqual:   false,
+			wantCompare: 1,
+		},
+		{
+			name:        "Test compare: prefix",
+			inputKeys:   []int{1, 2},
+			inputVals:   []int{1, 2},
+			otherKeys:   []int{1, 2, 3},
+			otherVals:   []int{1, 2, 3},
+			wantEqual:   false,
+			wantCompare: -1,
+		},
+	}
+	eq := func(a, b int) bool {
+		return a == b
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			avl := NewAVLTArray(tt.inputKeys, tt.inputVals)
+			avlOther := NewAVLTArray(tt.otherKeys, tt.otherVals)
+			bst := NewBSTArray(tt.inputKeys, tt.inputVals)
+			bstOther := NewBSTArray(tt.otherKeys, tt.otherVals)
+
+			if got := avl.Equal(avlOther, eq); got != tt.wantEqual {
+				t.Errorf("AVL: actual = %v, want %v", got, tt.wantEqual)
+			}
+			if got := bst.Equal(bstOther, eq); got != tt.wantEqual {
+				t.Errorf("BST: actual = %v, want %v", got, tt.wantEqual)
+			}
+			if got := avl.Compare(avlOther, cmp.Compare[int]); got != tt.wantCompare {
+				t.Errorf("AVL: actual = %v, want %v", got, tt.wantCompare)
+			}
+			if got := bstOther.Compare(bst, cmp.Compare[int]); got != -tt.wantCompare {
+				t.Errorf("BST reversed: actual = %v, want %v", got, -tt.wantCompare)
+			}
+		})
+	}
+
+	// An emptied tree equals a tree that was never filled
+	root := NewAVLTRoot(1, 1)
+	_, _ = root.Delete(1)
+	var empty *AVLTree[int, int]
+	if !root.Equal(empty, eq) || root.Compare(empty, cmp.Compare[int]) != 0 {
+		t.Errorf("empty trees are not equal")
+	}
+}
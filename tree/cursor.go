@@ -0,0 +1,137 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// Cursor walks the keys of an AVLTree in both directions, one step at
+// a time. Rotations move entries between nodes, so the cursor keeps the
+// key it is positioned at rather than a node, and every step searches
+// the next key from the root in O(log n). The cursor therefore stays
+// valid whatever happens to the tree in between steps, including
+// rebalancing after Cursor.Delete.
+type Cursor[K cmp.Ordered, V any] struct {
+	tree  *AVLTree[K, V]
+	entry *TreeNode[K, V]
+}
+
+// Cursor returns a cursor over the tree that is not positioned yet.
+func (tree *AVLTree[K, V]) Cursor() *Cursor[K, V] {
+	return &Cursor[K, V]{
+		tree: tree,
+	}
+}
+
+// Delete removes the key at the cursor from the tree and moves the
+// cursor to the next key. It returns the removed value.
+func (cursor *Cursor[K, V]) Delete() (V, error) {
+	if !cursor.Valid() {
+		var empty V
+		return empty, fmt.Errorf("cursor is not positioned")
+	}
+	key := cursor.entry.key
+	value, err := cursor.tree.Delete(key)
+	if err != nil {
+		return value, fmt.Errorf("%w", err)
+	}
+	cursor.entry = cursor.tree.search(key, false, false)
+	return value, nil
+}
+
+// Key returns the key at the cursor.
+// It must only be called while the cursor is Valid.
+func (cursor *Cursor[K, V]) Key() K {
+	return cursor.entry.key
+}
+
+// Next moves the cursor to the next greater key and reports whether
+// there is one. Past the last key, the cursor is no longer valid.
+func (cursor *Cursor[K, V]) Next() bool {
+	if !cursor.Valid() {
+		return false
+	}
+	cursor.entry = cursor.tree.search(cursor.entry.key, false, false)
+	return cursor.Valid()
+}
+
+// Prev moves the cursor to the next smaller key and reports whether
+// there is one. Before the first key, the cursor is no longer valid.
+func (cursor *Cursor[K, V]) Prev() bool {
+	if !cursor.Valid() {
+		return false
+	}
+	cursor.entry = cursor.tree.search(cursor.entry.key, true, false)
+	return cursor.Valid()
+}
+
+// Seek moves the cursor to the smallest key greater than or equal
+// to key and reports whether there is one.
+func (cursor *Cursor[K, V]) Seek(key K) bool {
+	cursor.entry = cursor.tree.search(key, false, true)
+	return cursor.Valid()
+}
+
+// SeekFirst moves the cursor to the smallest key
+// and reports whether the tree has any key.
+func (cursor *Cursor[K, V]) SeekFirst() bool {
+	cursor.entry = nil
+	node := cursor.tree
+	for !node.IsEmpty() {
+		cursor.entry = node.TreeNode
+		node = node.left
+	}
+	return cursor.Valid()
+}
+
+// SeekLast moves the cursor to the greatest key
+// and reports whether the tree has any key.
+func (cursor *Cursor[K, V]) SeekLast() bool {
+	cursor.entry = nil
+	node := cursor.tree
+	for !node.IsEmpty() {
+		cursor.entry = node.TreeNode
+		node = node.right
+	}
+	return cursor.Valid()
+}
+
+// Valid reports whether the cursor is positioned at a key.
+func (cursor *Cursor[K, V]) Valid() bool {
+	return cursor.entry != nil
+}
+
+// Value returns the value at the cursor, as it was when the cursor
+// moved there. It must only be called while the cursor is Valid.
+func (cursor *Cursor[K, V]) Value() V {
+	return cursor.entry.value
+}
+
+// search returns the entry with the closest key after key, or before
+// key if backward is set. With inclusive set, the key itself matches.
+// It returns nil if there is no such key.
+func (tree *AVLTree[K, V]) search(key K, backward, inclusive bool) *TreeNode[K, V] {
+	var found *TreeNode[K, V]
+	node := tree
+	for !node.IsEmpty() {
+		if inclusive && node.key == key {
+			return node.TreeNode
+		}
+		if backward {
+			if node.key < key {
+				found = node.TreeNode
+				node = node.right
+			} else {
+				node = node.left
+			}
+		} else {
+			if node.key > key {
+				found = node.TreeNode
+				node = node.left
+			} else {
+				node = node.right
+			}
+		}
+	}
+	return found
+}
@@ -0,0 +1,162 @@
+package tree
+
+import (
+	"slices"
+	"testing"
+)
+
+type testCursorSeek[K any] struct {
+	name      string
+	seek      K
+	wantValid bool
+	wantKey   K
+}
+
+func TestCursorSeek(t *testing.T) {
+	root := NewAVLTArray([]int{10, 20, 30, 40, 50}, []int{1, 2, 3, 4, 5})
+	tests := []testCursorSeek[int]{
+		{
+			name:      "Test seek: existing key",
+			seek:      30,
+			wantValid: true,
+			wantKey:   30,
+		},
+		{
+			name:      "Test seek: between keys",
+			seek:      31,
+			wantValid: true,
+			wantKey:   40,
+		},
+		{
+			name:      "Test seek: before the first key",
+			seek:      0,
+			wantValid: true,
+			wantKey:   10,
+		},
+		{
+			name:      "Test seek: after the last key",
+			seek:      51,
+			wantValid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor := root.Cursor()
+			if got := cursor.Seek(tt.seek); got != tt.wantValid {
+				t.Fatalf("actual = %v, want %v", got, tt.wantValid)
+			}
+			if tt.wantValid && cursor.Key() != tt.wantKey {
+				t.Errorf("actual = %v, want %v", cursor.Key(), tt.wantKey)
+			}
+		})
+	}
+}
+
+func TestCursorWalk(t *testing.T) {
+	keys := []int{5, 6, 2, 10, 12, 3, 1, 9}
+	root := NewAVLTArray(keys, keys)
+	sorted := slices.Sorted(slices.Values(keys))
+
+	cursor := root.Cursor()
+	if cursor.Valid() || cursor.Next() || cursor.Prev() {
+		t.Errorf("new cursor is positioned")
+	}
+
+	got := []int{}
+	for ok := cursor.SeekFirst(); ok; ok = cursor.Next() {
+		if cursor.Key() != cursor.Value() {
+			t.Errorf("actual value = %v, want %v", cursor.Value(), cursor.Key())
+		}
+		got = append(got, cursor.Key())
+	}
+	if !slices.Equal(got, sorted) {
+		t.Errorf("actual = %v, want %v", got, sorted)
+	}
+
+	got = []int{}
+	for ok := cursor.SeekLast(); ok; ok = cursor.Prev() {
+		got = append(got, cursor.Key())
+	}
+	slices.Reverse(got)
+	if !slices.Equal(got, sorted) {
+		t.Errorf("actual = %v, want %v", got, sorted)
+	}
+
+	// Changing direction in the middle
+	cursor.Seek(6)
+	cursor.Next()
+	cursor.Prev()
+	cursor.Prev()
+	if cursor.Key() != 5 {
+		t.Errorf("actual = %v, want %v", cursor.Key(), 5)
+	}
+
+	// The tree changes in between steps
+	_ = root.Add(7, 7)
+	_, _ = root.Delete(9)
+	cursor.Next()
+	cursor.Next()
+	cursor.Next()
+	if cursor.Key() != 10 {
+		t.Errorf("actual = %v, want %v", cursor.Key(), 10)
+	}
+
+	empty := NewAVLTRoot(1, 1)
+	_, _ = empty.Delete(1)
+	if empty.Cursor().SeekFirst() || empty.Cursor().SeekLast() || empty.Cursor().Seek(0) {
+		t.Errorf("cursor is positioned in an empty tree")
+	}
+}
+
+func TestCursorDelete(t *testing.T) {
+	keys := make([]int, 64)
+	for i := range keys {
+		keys[i] = i
+	}
+	root := NewAVLTArray(keys, keys)
+
+	// Delete every even key while walking, which rebalances the tree
+	cursor := root.Cursor()
+	for ok := cursor.SeekFirst(); ok; {
+		if cursor.Key()%2 != 0 {
+			ok = cursor.Next()
+			continue
+		}
+		value, err := cursor.Delete()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if value%2 != 0 {
+			t.Errorf("actual removed value = %v, want an even value", value)
+		}
+		if err := root.Validate(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ok = cursor.Valid()
+	}
+
+	got := []int{}
+	for _, node := range root.InorderTraversal() {
+		got = append(got, node.key)
+	}
+	for i, key := range got {
+		if key != 2*i+1 {
+			t.Fatalf("actual = %v, want the odd keys", got)
+		}
+	}
+	if len(got) != 32 {
+		t.Errorf("actual length = %v, want length %v", len(got), 32)
+	}
+
+	// Deleting the last key leaves the cursor past the end
+	cursor.SeekLast()
+	if _, err := cursor.Delete(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cursor.Valid() {
+		t.Errorf("cursor is positioned after deleting the last key")
+	}
+	if _, err := cursor.Delete(); err == nil {
+		t.Errorf("expected error when deleting at an invalid cursor")
+	}
+}
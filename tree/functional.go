@@ -0,0 +1,85 @@
+package tree
+
+import (
+	"cmp"
+)
+
+// Filter returns a new tree with the entries for which keep returns
+// true. The entries are visited in key order and the new tree is built
+// balanced in O(n). If no entry is kept, the new tree is empty.
+func (tree *AVLTree[K, V]) Filter(keep func(key K, value V) bool) *AVLTree[K, V] {
+	keys, values := filterEntries(tree.walkInorder, keep)
+	return newAVLTreeSorted(keys, values)
+}
+
+// Filter returns a new tree with the entries for which keep returns
+// true. The entries are visited in key order and the new tree is built
+// balanced in O(n). If no entry is kept, the new tree is empty.
+func (tree *BinarySearchTree[K, V]) Filter(keep func(key K, value V) bool) *BinarySearchTree[K, V] {
+	keys, values := filterEntries(tree.walkInorder, keep)
+	return newBSTreeSorted(keys, values)
+}
+
+// MapAVLValues returns a new tree with the same keys and fn applied to
+// every value. The values are visited in key order and the new tree is
+// built balanced in O(n).
+func MapAVLValues[K cmp.Ordered, V, W any](tree *AVLTree[K, V], fn func(key K, value V) W) *AVLTree[K, W] {
+	keys, values := mapEntries(tree.walkInorder, fn)
+	return newAVLTreeSorted(keys, values)
+}
+
+// MapBSTValues returns a new tree with the same keys and fn applied to
+// every value. The values are visited in key order and the new tree is
+// built balanced in O(n).
+func MapBSTValues[K cmp.Ordered, V, W any](tree *BinarySearchTree[K, V], fn func(key K, value V) W) *BinarySearchTree[K, W] {
+	keys, values := mapEntries(tree.walkInorder, fn)
+	return newBSTreeSorted(keys, values)
+}
+
+func filterEntries[K cmp.Ordered, V any, N validateNode[K, V, N]](walk func(func(N) bool) bool, keep func(K, V) bool) ([]K, []V) {
+	var (
+		keys   []K
+		values []V
+	)
+	walk(func(node N) bool {
+		entry := node.treeNode()
+		if keep(entry.key, entry.value) {
+			keys = append(keys, entry.key)
+			values = append(values, entry.value)
+		}
+		return true
+	})
+	return keys, values
+}
+
+func mapEntries[K cmp.Ordered, V, W any, N validateNode[K, V, N]](walk func(func(N) bool) bool, fn func(K, V) W) ([]K, []W) {
+	var (
+		keys   []K
+		values []W
+	)
+	walk(func(node N) bool {
+		entry := node.treeNode()
+		keys = append(keys, entry.key)
+		values = append(values, fn(entry.key, entry.value))
+		return true
+	})
+	return keys, values
+}
+
+// newAVLTreeSorted builds a balanced tree from sorted keys,
+// or an empty root if there are none.
+func newAVLTreeSorted[K cmp.Ordered, V any](keys []K, values []V) *AVLTree[K, V] {
+	if len(keys) == 0 {
+		return &AVLTree[K, V]{}
+	}
+	return buildAVLTree(keys, values)
+}
+
+// newBSTreeSorted builds a balanced tree from sorted keys,
+// or an empty root if there are none.
+func newBSTreeSorted[K cmp.Ordered, V any](keys []K, values []V) *BinarySearchTree[K, V] {
+	if len(keys) == 0 {
+		return &BinarySearchTree[K, V]{}
+	}
+	return buildBSTree(keys, values)
+}
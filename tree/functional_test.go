@@ -0,0 +1,105 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+	"testing"
+)
+
+type testTreeFilter[K cmp.Ordered, V any] struct {
+	name        string
+	inputKeys   []K
+	inputVals   []V
+	wantTreeKey []K
+}
+
+func TestTreeFilter(t *testing.T) {
+	tests := []testTreeFilter[int, int]{
+		{
+			name: "Test filter: keep even keys",
+			inputKeys: []int{
+				5, 6, 2, 10, 12, 3, 1, 9,
+			},
+			inputVals: []int{
+				5, 6, 2, 10, 12, 3, 1, 9,
+			},
+			wantTreeKey: []int{
+				2, 6, 10, 12,
+			},
+		},
+		{
+			name: "Test filter: keep nothing",
+			inputKeys: []int{
+				5, 3,
+			},
+			inputVals: []int{
+				5, 3,
+			},
+			wantTreeKey: []int{},
+		},
+	}
+	isEven := func(key, value int) bool {
+		return key%2 == 0
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			avl := NewAVLTArray(tt.inputKeys, tt.inputVals).Filter(isEven)
+			bst := NewBSTArray(tt.inputKeys, tt.inputVals).Filter(isEven)
+			if err := avl.Validate(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := bst.Validate(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if avl.IsEmpty() != (len(tt.wantTreeKey) == 0) || bst.IsEmpty() != (len(tt.wantTreeKey) == 0) {
+				t.Errorf("actual empty = %v, %v, want %v", avl.IsEmpty(), bst.IsEmpty(), len(tt.wantTreeKey) == 0)
+			}
+
+			gotAVL := avl.InorderTraversal()
+			gotBST := bst.InorderTraversal()
+			if len(gotAVL) != len(tt.wantTreeKey) || len(gotBST) != len(tt.wantTreeKey) {
+				t.Fatalf("actual length = %v, %v, want length %v", len(gotAVL), len(gotBST), len(tt.wantTreeKey))
+			}
+			for i, wantKey := range tt.wantTreeKey {
+				if gotAVL[i].key != wantKey || gotBST[i].key != wantKey {
+					t.Errorf("actual = %v, %v, want %v", gotAVL[i].key, gotBST[i].key, wantKey)
+				}
+			}
+		})
+	}
+}
+
+func TestTreeMapValues(t *testing.T) {
+	keys := []int{5, 6, 2, 10, 12, 3, 1, 9}
+	label := func(key, value int) string {
+		return fmt.Sprintf("%v=%v", key, value*10)
+	}
+
+	avl := MapAVLValues(NewAVLTArray(keys, keys), label)
+	bst := MapBSTValues(NewBSTArray(keys, keys), label)
+	if err := avl.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The source BST is not balanced, the mapped one is
+	if bst.Height() > 3 {
+		t.Errorf("actual height = %v, want at most %v", bst.Height(), 3)
+	}
+
+	gotAVL := avl.InorderTraversal()
+	gotBST := bst.InorderTraversal()
+	for i, node := range gotAVL {
+		want := fmt.Sprintf("%v=%v", node.key, node.key*10)
+		if node.value != want || gotBST[i].value != want {
+			t.Errorf("actual = %v, %v, want %v", node.value, gotBST[i].value, want)
+		}
+	}
+	if len(gotAVL) != len(keys) || len(gotBST) != len(keys) {
+		t.Errorf("actual length = %v, %v, want length %v", len(gotAVL), len(gotBST), len(keys))
+	}
+
+	// Mapping an empty tree gives an empty tree
+	var empty *AVLTree[int, int]
+	if mapped := MapAVLValues(empty, label); !mapped.IsEmpty() {
+		t.Errorf("mapped tree is not empty")
+	}
+}
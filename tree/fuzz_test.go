@@ -0,0 +1,112 @@
+package tree
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+// fuzzTree is what runTreeOps needs from the trees under test.
+type fuzzTree interface {
+	Add(key int, value int) error
+	Delete(key int) (int, error)
+	IsEmpty() bool
+	Lookup(key int) (int, bool)
+	PageFirst(limit int) ([]Entry[int, int], int, bool)
+	Update(key int, value int) error
+	Validate() error
+}
+
+// addTreeSeeds adds the seed inputs shared by the tree fuzz targets.
+func addTreeSeeds(f *testing.F) {
+	f.Add([]byte{0, 1, 0, 2, 0, 3, 1, 2})
+	f.Add([]byte{0, 5, 0, 3, 0, 8, 0, 1, 0, 4, 1, 5, 2, 3, 3, 4})
+}
+
+// runTreeOps decodes the fuzz input as a sequence of (operation, key)
+// byte pairs, applies them to a tree holding only the key 32 and checks
+// the tree against a reference map after every operation.
+func runTreeOps(t *testing.T, root fuzzTree, data []byte) {
+	model := map[int]int{32: 0}
+
+	for i := 0; i+1 < len(data); i += 2 {
+		op, key := data[i]%4, int(data[i+1]%64)
+		switch op {
+		case 0:
+			err := root.Add(key, key+100)
+			_, exists := model[key]
+			if (err != nil) != exists {
+				t.Fatalf("Add(%v) error = %v, key exists %v", key, err, exists)
+			}
+			if !exists {
+				model[key] = key + 100
+			}
+		case 1:
+			value, err := root.Delete(key)
+			want, exists := model[key]
+			if (err != nil) == exists {
+				t.Fatalf("Delete(%v) error = %v, key exists %v", key, err, exists)
+			}
+			if exists && value != want {
+				t.Fatalf("Delete(%v) = %v, want %v", key, value, want)
+			}
+			delete(model, key)
+			if root.IsEmpty() != (len(model) == 0) {
+				t.Fatalf("Delete(%v) left IsEmpty = %v with %v keys", key, root.IsEmpty(), len(model))
+			}
+		case 2:
+			err := root.Update(key, key+200)
+			_, exists := model[key]
+			if (err != nil) == exists {
+				t.Fatalf("Update(%v) error = %v, key exists %v", key, err, exists)
+			}
+			if exists {
+				model[key] = key + 200
+			}
+		case 3:
+			value, ok := root.Lookup(key)
+			want, exists := model[key]
+			if ok != exists {
+				t.Fatalf("Lookup(%v) found = %v, key exists %v", key, ok, exists)
+			}
+			if exists && value != want {
+				t.Fatalf("Lookup(%v) = %v, want %v", key, value, want)
+			}
+		}
+
+		if err := root.Validate(); err != nil {
+			t.Fatalf("invalid tree after op %v(%v): %v", op, key, err)
+		}
+		entries, _, _ := root.PageFirst(math.MaxInt)
+		checkTreeModel(t, entries, model)
+	}
+}
+
+// checkTreeModel compares the in-order entries of a tree
+// with a reference map.
+func checkTreeModel(t *testing.T, entries []Entry[int, int], model map[int]int) {
+	t.Helper()
+	wantKeys := make([]int, 0, len(model))
+	for key := range model {
+		wantKeys = append(wantKeys, key)
+	}
+	slices.Sort(wantKeys)
+	if keys := pageKeys(entries); !slices.Equal(keys, wantKeys) {
+		t.Fatalf("actual keys = %v, want %v", keys, wantKeys)
+	}
+	for _, entry := range entries {
+		if entry.Value != model[entry.Key] {
+			t.Fatalf("actual value of %v = %v, want %v", entry.Key, entry.Value, model[entry.Key])
+		}
+	}
+}
+
+func FuzzAVLTree(f *testing.F) {
+	addTreeSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) { runTreeOps(t, NewAVLTRoot(32, 0), data) })
+}
+
+func FuzzBinarySearchTree(f *testing.F) {
+	addTreeSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) { runTreeOps(t, NewBSTRoot(32, 0), data) })
+}
@@ -0,0 +1,379 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+)
+
+// Interval is a closed range [Low, High].
+type Interval[K cmp.Ordered] struct {
+	Low  K
+	High K
+}
+
+// Overlaps reports whether the two closed intervals share at least one point.
+func (iv Interval[K]) Overlaps(other Interval[K]) bool {
+	return iv.Low <= other.High && other.Low <= iv.High
+}
+
+// Contains reports whether the point lies inside the interval.
+func (iv Interval[K]) Contains(point K) bool {
+	return iv.Low <= point && point <= iv.High
+}
+
+// IntervalTree is an AVL tree ordered by the low endpoint of each interval
+// (ties are broken by the high endpoint). Every node is augmented with the
+// maximum high endpoint found in its subtree, which lets overlap and stabbing
+// queries skip whole subtrees.
+// A root whose TreeNode is nil is an empty tree, which is what is left
+// after the last interval has been deleted.
+type IntervalTree[K cmp.Ordered, V any] struct {
+	*TreeNode[K, V]
+	high   K
+	max    K
+	height int
+	left   *IntervalTree[K, V]
+	right  *IntervalTree[K, V]
+}
+
+func NewIntervalTreeArray[K cmp.Ordered, V any](intervals []Interval[K], values []V) (*IntervalTree[K, V], error) {
+	if len(intervals) == 0 {
+		return nil, nil
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	root, err := NewIntervalTreeRoot(intervals[0].Low, intervals[0].High, values[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	for i := 1; i < len(intervals); i++ {
+		err = root.Add(intervals[i].Low, intervals[i].High, values[i])
+		if err != nil {
+			return root, fmt.Errorf("%w", err)
+		}
+	}
+	return root, nil
+}
+
+func NewIntervalTreeRoot[K cmp.Ordered, V any](low, high K, value V) (*IntervalTree[K, V], error) {
+	if low > high {
+		return nil, fmt.Errorf("invalid interval: low %v is greater than high %v", low, high)
+	}
+	return newIntervalNode(low, high, value), nil
+}
+
+func newIntervalNode[K cmp.Ordered, V any](low, high K, value V) *IntervalTree[K, V] {
+	return &IntervalTree[K, V]{
+		TreeNode: &TreeNode[K, V]{
+			key:   low,
+			value: value,
+		},
+		high: high,
+		max:  high,
+	}
+}
+
+// Add inserts the interval [low, high] into the tree and rebalances
+// the path back to the receiver. Adding an interval whose both endpoints
+// already exist in the tree returns an error.
+func (tree *IntervalTree[K, V]) Add(low, high K, value V) error {
+	if low > high {
+		return fmt.Errorf("invalid interval: low %v is greater than high %v", low, high)
+	}
+
+	return tree.AddNode(newIntervalNode(low, high, value))
+}
+
+func (tree *IntervalTree[K, V]) AddNode(node *IntervalTree[K, V]) error {
+	if node == nil {
+		return nil
+	}
+
+	// A root emptied by Delete takes the node as it is
+	if tree.IsEmpty() {
+		*tree = *node
+		return nil
+	}
+
+	var err error
+	switch compareInterval(node.Interval(), tree.Interval()) {
+	case -1:
+		if tree.left == nil {
+			tree.left = node
+		} else {
+			err = tree.left.AddNode(node)
+		}
+	case 1:
+		if tree.right == nil {
+			tree.right = node
+		} else {
+			err = tree.right.AddNode(node)
+		}
+	default:
+		return fmt.Errorf("interval already exists")
+	}
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	tree.rebalance()
+	return nil
+}
+
+func (tree *IntervalTree[K, V]) Clear() *IntervalTree[K, V] {
+	if tree == nil {
+		return nil
+	}
+	tree.left = tree.left.Clear()
+	tree.right = tree.right.Clear()
+	tree = nil
+	return tree
+}
+
+// Delete removes the interval [low, high] from the tree.
+// The removed node is replaced by its in-order successor, so the
+// tree only needs to be rebalanced along the path to the receiver.
+func (tree *IntervalTree[K, V]) Delete(low, high K) error {
+	if tree.IsEmpty() {
+		return fmt.Errorf("interval not found")
+	}
+
+	root, err := deleteInterval(tree, Interval[K]{Low: low, High: high})
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	// The receiver must stay the root, so copy the new root into it
+	// or mark it as empty when the last interval is gone.
+	if root == nil {
+		*tree = IntervalTree[K, V]{}
+	} else if root != tree {
+		*tree = *root
+	}
+	return nil
+}
+
+func (tree *IntervalTree[K, V]) GetBalance() int {
+	return tree.left.Height() - tree.right.Height()
+}
+
+func (tree *IntervalTree[K, V]) Height() int {
+	if tree == nil || tree.TreeNode == nil {
+		return -1
+	}
+	return tree.height
+}
+
+func (tree *IntervalTree[K, V]) InorderTraversal() []*IntervalTree[K, V] {
+	if tree.IsEmpty() {
+		return []*IntervalTree[K, V]{}
+	}
+
+	left := tree.left.InorderTraversal()
+	right := tree.right.InorderTraversal()
+
+	return append(left, append([]*IntervalTree[K, V]{tree}, right...)...)
+}
+
+// Interval returns the interval stored in the node.
+func (tree *IntervalTree[K, V]) Interval() Interval[K] {
+	return Interval[K]{Low: tree.key, High: tree.high}
+}
+
+func (tree *IntervalTree[K, V]) IsEmpty() bool {
+	return tree == nil || tree.TreeNode == nil
+}
+
+// Key returns the low endpoint of the node and false if the tree is empty.
+func (tree *IntervalTree[K, V]) Key() (K, bool) {
+	if tree.IsEmpty() {
+		var empty K
+		return empty, false
+	}
+	return tree.key, true
+}
+
+// MaxHigh returns the largest high endpoint stored in the subtree.
+func (tree *IntervalTree[K, V]) MaxHigh() (K, error) {
+	if tree.IsEmpty() {
+		var empty K
+		return empty, fmt.Errorf("tree is empty")
+	}
+	return tree.max, nil
+}
+
+// Overlap iterates, in ascending order, over every interval that
+// shares at least one point with [low, high].
+func (tree *IntervalTree[K, V]) Overlap(low, high K) iter.Seq2[Interval[K], V] {
+	query := Interval[K]{Low: low, High: high}
+	return func(yield func(Interval[K], V) bool) {
+		if tree.IsEmpty() {
+			return
+		}
+		tree.overlap(query, yield)
+	}
+}
+
+func (tree *IntervalTree[K, V]) overlap(query Interval[K], yield func(Interval[K], V) bool) bool {
+	// No interval below this node reaches the query
+	if tree == nil || tree.max < query.Low {
+		return true
+	}
+	if !tree.left.overlap(query, yield) {
+		return false
+	}
+	// Every interval on the right starts after the query ends
+	if tree.key > query.High {
+		return true
+	}
+	if tree.Interval().Overlaps(query) && !yield(tree.Interval(), tree.value) {
+		return false
+	}
+	return tree.right.overlap(query, yield)
+}
+
+// SetValue replaces the value of the node in place.
+// It fails if the tree is empty, as there is no node to hold the value.
+func (tree *IntervalTree[K, V]) SetValue(value V) error {
+	if tree.IsEmpty() {
+		return fmt.Errorf("tree is empty")
+	}
+	tree.value = value
+	return nil
+}
+
+// Stab iterates, in ascending order, over every interval containing point.
+func (tree *IntervalTree[K, V]) Stab(point K) iter.Seq2[Interval[K], V] {
+	return tree.Overlap(point, point)
+}
+
+// Value returns the value of the node and false if the tree is empty.
+func (tree *IntervalTree[K, V]) Value() (V, bool) {
+	if tree.IsEmpty() {
+		var empty V
+		return empty, false
+	}
+	return tree.value, true
+}
+
+// RotateLeft rotates the subtree to the left while keeping the receiver
+// as the subtree root, and refreshes the cached height and max endpoint.
+func (tree *IntervalTree[K, V]) RotateLeft() {
+	if tree.right == nil {
+		return
+	}
+
+	// Detach the pivot's left subtree and hang it under the old root
+	pivot := tree.right
+	tree.right = pivot.left
+	tree.update()
+
+	// Swap contents so the receiver holds the pivot and the
+	// pivot's allocation holds the old root
+	*tree, *pivot = *pivot, *tree
+	tree.left = pivot
+	tree.update()
+}
+
+// RotateRight rotates the subtree to the right while keeping the receiver
+// as the subtree root, and refreshes the cached height and max endpoint.
+func (tree *IntervalTree[K, V]) RotateRight() {
+	if tree.left == nil {
+		return
+	}
+
+	// Detach the pivot's right subtree and hang it under the old root
+	pivot := tree.left
+	tree.left = pivot.right
+	tree.update()
+
+	// Swap contents so the receiver holds the pivot and the
+	// pivot's allocation holds the old root
+	*tree, *pivot = *pivot, *tree
+	tree.right = pivot
+	tree.update()
+}
+
+// rebalance refreshes the node's augmentation and performs
+// the AVL rotations needed to bring its balance back to [-1, 1].
+func (tree *IntervalTree[K, V]) rebalance() {
+	tree.update()
+
+	if tree.GetBalance() > 1 {
+		if tree.left.GetBalance() < 0 {
+			tree.left.RotateLeft()
+		}
+		tree.RotateRight()
+	}
+
+	if tree.GetBalance() < -1 {
+		if tree.right.GetBalance() > 0 {
+			tree.right.RotateRight()
+		}
+		tree.RotateLeft()
+	}
+}
+
+// update recomputes the cached height and max endpoint from the children.
+func (tree *IntervalTree[K, V]) update() {
+	tree.height = 1 + max(tree.left.Height(), tree.right.Height())
+	tree.max = tree.high
+	if tree.left != nil {
+		tree.max = max(tree.max, tree.left.max)
+	}
+	if tree.right != nil {
+		tree.max = max(tree.max, tree.right.max)
+	}
+}
+
+// compareInterval orders intervals by low endpoint, then by high endpoint.
+func compareInterval[K cmp.Ordered](a, b Interval[K]) int {
+	if c := cmp.Compare(a.Low, b.Low); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.High, b.High)
+}
+
+func deleteInterval[K cmp.Ordered, V any](tree *IntervalTree[K, V], iv Interval[K]) (*IntervalTree[K, V], error) {
+	var err error
+	// If the node is not found, return an error
+	if tree == nil {
+		return nil, fmt.Errorf("interval not found")
+	}
+
+	switch compareInterval(iv, tree.Interval()) {
+	case -1:
+		tree.left, err = deleteInterval(tree.left, iv)
+		if err != nil {
+			return tree, fmt.Errorf("%w", err)
+		}
+	case 1:
+		tree.right, err = deleteInterval(tree.right, iv)
+		if err != nil {
+			return tree, fmt.Errorf("%w", err)
+		}
+	default:
+		// A node with at most one child is replaced by that child
+		if tree.left == nil {
+			return tree.right, nil
+		}
+		if tree.right == nil {
+			return tree.left, nil
+		}
+
+		// Otherwise take over the in-order successor's interval
+		// and remove the successor from the right subtree
+		successor := tree.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		tree.TreeNode = successor.TreeNode
+		tree.high = successor.high
+		tree.right, _ = deleteInterval(tree.right, successor.Interval())
+	}
+
+	tree.rebalance()
+	return tree, nil
+}
@@ -0,0 +1,352 @@
+package tree
+
+import (
+	"cmp"
+	"testing"
+)
+
+type testIntervalTree[K cmp.Ordered, V any] struct {
+	name          string
+	inputInterval []Interval[K]
+	inputVals     []V
+	queryLow      K
+	queryHigh     K
+	wantVal       []V
+}
+
+func TestIntervalTreeOverlap(t *testing.T) {
+	intervals := []Interval[int]{
+		{15, 20}, {10, 30}, {17, 19}, {5, 20}, {12, 15}, {30, 40},
+	}
+	values := []string{
+		"a", "b", "c", "d", "e", "f",
+	}
+	tests := []testIntervalTree[int, string]{
+		{
+			name:          "Test overlap: query inside several intervals",
+			inputInterval: intervals,
+			inputVals:     values,
+			queryLow:      14,
+			queryHigh:     16,
+			wantVal: []string{
+				"d", "b", "e", "a",
+			},
+		},
+		{
+			name:          "Test overlap: query touching endpoints",
+			inputInterval: intervals,
+			inputVals:     values,
+			queryLow:      30,
+			queryHigh:     35,
+			wantVal: []string{
+				"b", "f",
+			},
+		},
+		{
+			name:          "Test overlap: query outside every interval",
+			inputInterval: intervals,
+			inputVals:     values,
+			queryLow:      41,
+			queryHigh:     50,
+			wantVal:       []string{},
+		},
+		{
+			name:          "Test overlap: empty tree",
+			inputInterval: []Interval[int]{},
+			inputVals:     []string{},
+			queryLow:      0,
+			queryHigh:     100,
+			wantVal:       []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, err := NewIntervalTreeArray(tt.inputInterval, tt.inputVals)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := []string{}
+			for _, value := range root.Overlap(tt.queryLow, tt.queryHigh) {
+				got = append(got, value)
+			}
+			if len(got) != len(tt.wantVal) {
+				t.Fatalf("actual length = %v, want length %v", len(got), len(tt.wantVal))
+			}
+			for i, wantVal := range tt.wantVal {
+				if got[i] != wantVal {
+					t.Errorf("actual = %v, want %v", got[i], wantVal)
+				}
+			}
+		})
+	}
+}
+
+func TestIntervalTreeStab(t *testing.T) {
+	tests := []testIntervalTree[int, int]{
+		{
+			name: "Test stab: point inside nested intervals",
+			inputInterval: []Interval[int]{
+				{1, 10}, {2, 3}, {4, 8}, {5, 6}, {9, 12},
+			},
+			inputVals: []int{
+				1, 2, 3, 4, 5,
+			},
+			queryLow: 5,
+			wantVal: []int{
+				1, 3, 4,
+			},
+		},
+		{
+			name: "Test stab: point on shared endpoint",
+			inputInterval: []Interval[int]{
+				{1, 10}, {2, 3}, {4, 8}, {5, 6}, {9, 12},
+			},
+			inputVals: []int{
+				1, 2, 3, 4, 5,
+			},
+			queryLow: 10,
+			wantVal: []int{
+				1, 5,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, _ := NewIntervalTreeArray(tt.inputInterval, tt.inputVals)
+
+			got := []int{}
+			for iv, value := range root.Stab(tt.queryLow) {
+				if !iv.Contains(tt.queryLow) {
+					t.Errorf("interval %v does not contain %v", iv, tt.queryLow)
+				}
+				got = append(got, value)
+			}
+			if len(got) != len(tt.wantVal) {
+				t.Fatalf("actual length = %v, want length %v", len(got), len(tt.wantVal))
+			}
+			for i, wantVal := range tt.wantVal {
+				if got[i] != wantVal {
+					t.Errorf("actual = %v, want %v", got[i], wantVal)
+				}
+			}
+		})
+	}
+}
+
+type testIntervalTreeDelete[K cmp.Ordered, V any] struct {
+	name          string
+	inputInterval []Interval[K]
+	inputVals     []V
+	deleteKeys    []Interval[K]
+	wantError     bool
+	wantMax       K
+	wantTreeVal   []V
+}
+
+func TestIntervalTreeDelete(t *testing.T) {
+	tests := []testIntervalTreeDelete[int, int]{
+		{
+			name: "Test delete interval holding the max endpoint",
+			inputInterval: []Interval[int]{
+				{5, 10}, {1, 3}, {8, 50}, {6, 7}, {9, 11},
+			},
+			inputVals: []int{
+				5, 1, 8, 6, 9,
+			},
+			deleteKeys: []Interval[int]{
+				{8, 50},
+			},
+			wantMax: 11,
+			wantTreeVal: []int{
+				1, 5, 6, 9,
+			},
+		},
+		{
+			name: "Test delete the root interval",
+			inputInterval: []Interval[int]{
+				{4, 4}, {3, 3}, {1, 1}, {2, 2}, {6, 6}, {5, 5}, {7, 7},
+			},
+			inputVals: []int{
+				4, 3, 1, 2, 6, 5, 7,
+			},
+			deleteKeys: []Interval[int]{
+				{3, 3},
+			},
+			wantMax: 7,
+			wantTreeVal: []int{
+				1, 2, 4, 5, 6, 7,
+			},
+		},
+		{
+			name: "Test delete the same low endpoint with different high",
+			inputInterval: []Interval[int]{
+				{1, 5}, {1, 9},
+			},
+			inputVals: []int{
+				5, 9,
+			},
+			deleteKeys: []Interval[int]{
+				{1, 5},
+			},
+			wantMax: 9,
+			wantTreeVal: []int{
+				9,
+			},
+		},
+		{
+			name: "Test delete but interval not found",
+			inputInterval: []Interval[int]{
+				{1, 5}, {2, 3},
+			},
+			inputVals: []int{
+				1, 2,
+			},
+			deleteKeys: []Interval[int]{
+				{2, 4},
+			},
+			wantError: true,
+			wantMax:   5,
+			wantTreeVal: []int{
+				1, 2,
+			},
+		},
+		{
+			name: "Test delete every interval",
+			inputInterval: []Interval[int]{
+				{1, 5}, {2, 3},
+			},
+			inputVals: []int{
+				1, 2,
+			},
+			deleteKeys: []Interval[int]{
+				{1, 5}, {2, 3},
+			},
+			wantTreeVal: []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, _ := NewIntervalTreeArray(tt.inputInterval, tt.inputVals)
+
+			var err error
+			for _, iv := range tt.deleteKeys {
+				err = root.Delete(iv.Low, iv.High)
+			}
+			if (err != nil) != tt.wantError {
+				t.Errorf("actual error = %v, want error %v", err, tt.wantError)
+			}
+
+			got := root.InorderTraversal()
+			if len(got) != len(tt.wantTreeVal) {
+				t.Fatalf("actual length = %v, want length %v", len(got), len(tt.wantTreeVal))
+			}
+			for i, wantVal := range tt.wantTreeVal {
+				if got[i].value != wantVal {
+					t.Errorf("actual = %v, want %v", got[i].value, wantVal)
+				}
+			}
+			if len(tt.wantTreeVal) == 0 {
+				if !root.IsEmpty() {
+					t.Errorf("tree is not empty after deleting every interval")
+				}
+				return
+			}
+			if gotMax, _ := root.MaxHigh(); gotMax != tt.wantMax {
+				t.Errorf("actual max = %v, want max %v", gotMax, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestIntervalTreeBalance(t *testing.T) {
+	root, _ := NewIntervalTreeRoot(0, 2, 0)
+	for i := 1; i < 1000; i++ {
+		if err := root.Add(i, i+2, i); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := root.Add(10, 12, 10); err == nil {
+		t.Errorf("expected error when adding duplicate interval")
+	}
+	if err := root.Add(12, 10, 10); err == nil {
+		t.Errorf("expected error when adding interval with low > high")
+	}
+
+	for i := 0; i < 1000; i += 3 {
+		if err := root.Delete(i, i+2); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	for _, node := range root.InorderTraversal() {
+		if node.GetBalance() > 1 || node.GetBalance() < -1 {
+			t.Errorf("node %v is unbalanced: %v", node.Interval(), node.GetBalance())
+		}
+		wantMax := node.high
+		for _, child := range []*IntervalTree[int, int]{node.left, node.right} {
+			if child != nil {
+				wantMax = max(wantMax, child.max)
+			}
+		}
+		if node.max != wantMax {
+			t.Errorf("node %v has max %v, want %v", node.Interval(), node.max, wantMax)
+		}
+	}
+	if root.Height() > 14 {
+		t.Errorf("tree height %v is too large", root.Height())
+	}
+}
+
+func TestIntervalTreeAddNodeAfterDeletingAll(t *testing.T) {
+	root, _ := NewIntervalTreeArray([]Interval[int]{{1, 5}, {2, 3}}, []int{1, 2})
+	for _, iv := range []Interval[int]{{1, 5}, {2, 3}} {
+		if err := root.Delete(iv.Low, iv.High); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	node, _ := NewIntervalTreeRoot(4, 8, 4)
+	if err := root.AddNode(node); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := root.Add(6, 7, 6); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := root.InorderTraversal()
+	if len(got) != 2 || got[0].value != 4 || got[1].value != 6 {
+		t.Errorf("actual length = %v, want intervals [4, 8] and [6, 7]", len(got))
+	}
+	if gotMax, _ := root.MaxHigh(); gotMax != 8 {
+		t.Errorf("actual max = %v, want max %v", gotMax, 8)
+	}
+}
+
+func TestIntervalTreeAccessors(t *testing.T) {
+	root, _ := NewIntervalTreeArray([]Interval[int]{{1, 5}, {2, 3}}, []string{"a", "b"})
+	if key, ok := root.Key(); !ok || key != 1 {
+		t.Errorf("actual = %v, %v, want %v, %v", key, ok, 1, true)
+	}
+	if err := root.SetValue("A"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value, ok := root.Value(); !ok || value != "A" {
+		t.Errorf("actual = %v, %v, want %v, %v", value, ok, "A", true)
+	}
+
+	// An emptied root has no key or value to access
+	for _, iv := range []Interval[int]{{1, 5}, {2, 3}} {
+		if err := root.Delete(iv.Low, iv.High); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, ok := root.Key(); ok {
+		t.Errorf("expected no key in an empty tree")
+	}
+	if _, ok := root.Value(); ok {
+		t.Errorf("expected no value in an empty tree")
+	}
+	if err := root.SetValue("c"); err == nil {
+		t.Errorf("expected error when setting the value of an empty tree")
+	}
+}
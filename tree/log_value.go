@@ -0,0 +1,87 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+	"log/slog"
+)
+
+// maxLogEntries is the number of entries included by LogValue.
+// Larger trees are truncated and marked as such.
+const maxLogEntries = 32
+
+// LogValue implements slog.LogValuer. It logs at most 32 entries in
+// ascending key order, as attributes named after their keys.
+func (tree *AVLTree[K, V]) LogValue() slog.Value {
+	return logEntries(tree.walkInorder, maxLogEntries)
+}
+
+// LogValueN returns a slog.LogValuer like LogValue that logs at most
+// n entries. The entries are only collected when the record is handled.
+func (tree *AVLTree[K, V]) LogValueN(n int) slog.LogValuer {
+	return logValuer(func() slog.Value {
+		return logEntries(tree.walkInorder, n)
+	})
+}
+
+// LogValue implements slog.LogValuer. It logs at most 32 entries in
+// ascending key order, as attributes named after their keys.
+func (tree *BinarySearchTree[K, V]) LogValue() slog.Value {
+	return logEntries(tree.walkInorder, maxLogEntries)
+}
+
+// LogValueN returns a slog.LogValuer like LogValue that logs at most
+// n entries. The entries are only collected when the record is handled.
+func (tree *BinarySearchTree[K, V]) LogValueN(n int) slog.LogValuer {
+	return logValuer(func() slog.Value {
+		return logEntries(tree.walkInorder, n)
+	})
+}
+
+// walkInorder calls visit for every node in ascending key order
+// until visit returns false, without collecting the nodes first.
+func (tree *AVLTree[K, V]) walkInorder(visit func(*AVLTree[K, V]) bool) bool {
+	if tree.IsEmpty() {
+		return true
+	}
+	return tree.left.walkInorder(visit) && visit(tree) && tree.right.walkInorder(visit)
+}
+
+// walkInorder calls visit for every node in ascending key order
+// until visit returns false, without collecting the nodes first.
+func (tree *BinarySearchTree[K, V]) walkInorder(visit func(*BinarySearchTree[K, V]) bool) bool {
+	if tree.IsEmpty() {
+		return true
+	}
+	return tree.left.walkInorder(visit) && visit(tree) && tree.right.walkInorder(visit)
+}
+
+// logEntries logs at most limit entries in ascending key order.
+func logEntries[K cmp.Ordered, V any, N validateNode[K, V, N]](walk func(func(N) bool) bool, limit int) slog.Value {
+	var entries []slog.Attr
+	truncated := false
+	walk(func(node N) bool {
+		if len(entries) >= limit {
+			truncated = true
+			return false
+		}
+		entry := node.treeNode()
+		entries = append(entries, slog.Any(fmt.Sprint(entry.key), entry.value))
+		return true
+	})
+
+	attrs := []slog.Attr{
+		{Key: "entries", Value: slog.GroupValue(entries...)},
+	}
+	if truncated {
+		attrs = append(attrs, slog.Bool("truncated", true))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// logValuer adapts a function to slog.LogValuer.
+type logValuer func() slog.Value
+
+func (fn logValuer) LogValue() slog.Value {
+	return fn()
+}
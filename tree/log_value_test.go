@@ -0,0 +1,52 @@
+package tree
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestDebugTraversalAsListTo(t *testing.T) {
+	var sb strings.Builder
+	root := NewAVLTArray([]int{2, 1, 3}, []int{20, 10, 30})
+	if err := root.DebugLevelOrderTraversalAsListTo(&sb); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "Level Order Traversal:\n&{key:2 value:20}\n&{key:1 value:10}\n&{key:3 value:30}\n\n"
+	if sb.String() != want {
+		t.Errorf("actual = %q, want %q", sb.String(), want)
+	}
+
+	sb.Reset()
+	bst := NewBSTArray([]int{2, 1, 3}, []int{20, 10, 30})
+	if err := bst.DebugInorderTraversalAsListTo(&sb); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want = "Inorder Traversal:\n&{key:1 value:10}\n&{key:2 value:20}\n&{key:3 value:30}\n\n"
+	if sb.String() != want {
+		t.Errorf("actual = %q, want %q", sb.String(), want)
+	}
+}
+
+func TestTreeLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	logger.Info("index", "tree", NewAVLTArray([]string{"b", "a", "c"}, []int{2, 1, 3}).LogValueN(2))
+	logger.Info("index", "tree", NewBSTArray([]int{1}, []string{"one"}))
+
+	want := "" +
+		"level=INFO msg=index tree.entries.a=1 tree.entries.b=2 tree.truncated=true\n" +
+		"level=INFO msg=index tree.entries.1=one\n"
+	if buf.String() != want {
+		t.Errorf("actual = %q, want %q", buf.String(), want)
+	}
+}
@@ -0,0 +1,168 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/dukenmarga/gollection/codec"
+)
+
+// MarshalBinary encodes the tree using the default key and value codecs.
+func (tree *AVLTree[K, V]) MarshalBinary() ([]byte, error) {
+	return tree.MarshalBinaryWith(codec.Default[K](), codec.Default[V]())
+}
+
+// MarshalBinaryWith encodes the entries of the tree in ascending key
+// order, so UnmarshalBinaryWith can rebuild it in O(n) without any
+// comparison or rotation.
+func (tree *AVLTree[K, V]) MarshalBinaryWith(keyCodec codec.Codec[K], valueCodec codec.Codec[V]) ([]byte, error) {
+	nodes := tree.InorderTraversal()
+	keys := make([]K, len(nodes))
+	values := make([]V, len(nodes))
+	for i, node := range nodes {
+		keys[i], values[i] = node.key, node.value
+	}
+	return marshalEntries(codec.KindAVL, keys, values, keyCodec, valueCodec)
+}
+
+// UnmarshalBinary replaces the tree with data encoded by MarshalBinary.
+func (tree *AVLTree[K, V]) UnmarshalBinary(data []byte) error {
+	return tree.UnmarshalBinaryWith(data, codec.Default[K](), codec.Default[V]())
+}
+
+// UnmarshalBinaryWith replaces the tree with data encoded by
+// MarshalBinaryWith using the same codecs. The tree is rebuilt
+// perfectly balanced in O(n).
+func (tree *AVLTree[K, V]) UnmarshalBinaryWith(data []byte, keyCodec codec.Codec[K], valueCodec codec.Codec[V]) error {
+	keys, values, err := unmarshalEntries(data, codec.KindAVL, keyCodec, valueCodec)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if len(keys) == 0 {
+		*tree = AVLTree[K, V]{}
+		return nil
+	}
+
+	*tree = *buildAVLTree(keys, values)
+	return nil
+}
+
+// MarshalBinary encodes the tree using the default key and value codecs.
+func (tree *BinarySearchTree[K, V]) MarshalBinary() ([]byte, error) {
+	return tree.MarshalBinaryWith(codec.Default[K](), codec.Default[V]())
+}
+
+// MarshalBinaryWith encodes the entries of the tree in ascending key
+// order, so UnmarshalBinaryWith can rebuild it in O(n).
+// The shape of the tree is not preserved.
+func (tree *BinarySearchTree[K, V]) MarshalBinaryWith(keyCodec codec.Codec[K], valueCodec codec.Codec[V]) ([]byte, error) {
+	nodes := tree.InorderTraversal()
+	keys := make([]K, len(nodes))
+	values := make([]V, len(nodes))
+	for i, node := range nodes {
+		keys[i], values[i] = node.key, node.value
+	}
+	return marshalEntries(codec.KindBST, keys, values, keyCodec, valueCodec)
+}
+
+// UnmarshalBinary replaces the tree with data encoded by MarshalBinary.
+func (tree *BinarySearchTree[K, V]) UnmarshalBinary(data []byte) error {
+	return tree.UnmarshalBinaryWith(data, codec.Default[K](), codec.Default[V]())
+}
+
+// UnmarshalBinaryWith replaces the tree with data encoded by
+// MarshalBinaryWith using the same codecs. The tree is rebuilt
+// balanced in O(n).
+func (tree *BinarySearchTree[K, V]) UnmarshalBinaryWith(data []byte, keyCodec codec.Codec[K], valueCodec codec.Codec[V]) error {
+	keys, values, err := unmarshalEntries(data, codec.KindBST, keyCodec, valueCodec)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if len(keys) == 0 {
+		*tree = BinarySearchTree[K, V]{}
+		return nil
+	}
+
+	*tree = *buildBSTree(keys, values)
+	return nil
+}
+
+// buildAVLTree builds a balanced tree from keys sorted in ascending
+// order by taking the middle key as the root of every subtree.
+func buildAVLTree[K cmp.Ordered, V any](keys []K, values []V) *AVLTree[K, V] {
+	if len(keys) == 0 {
+		return nil
+	}
+	mid := len(keys) / 2
+	node := NewAVLTRoot(keys[mid], values[mid])
+	node.left = buildAVLTree(keys[:mid], values[:mid])
+	node.right = buildAVLTree(keys[mid+1:], values[mid+1:])
+	return node
+}
+
+// buildBSTree builds a balanced tree from keys sorted in ascending
+// order by taking the middle key as the root of every subtree.
+func buildBSTree[K cmp.Ordered, V any](keys []K, values []V) *BinarySearchTree[K, V] {
+	if len(keys) == 0 {
+		return nil
+	}
+	mid := len(keys) / 2
+	node := NewBSTRoot(keys[mid], values[mid])
+	node.left = buildBSTree(keys[:mid], values[:mid])
+	node.right = buildBSTree(keys[mid+1:], values[mid+1:])
+	return node
+}
+
+func marshalEntries[K cmp.Ordered, V any](kind byte, keys []K, values []V, keyCodec codec.Codec[K], valueCodec codec.Codec[V]) ([]byte, error) {
+	var err error
+	buf := codec.AppendHeader(nil, kind, uint64(len(keys)))
+	for i := range keys {
+		buf, err = keyCodec.Append(buf, keys[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		buf, err = valueCodec.Append(buf, values[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	}
+	return codec.Seal(buf), nil
+}
+
+// unmarshalEntries decodes the entries of a tree frame and checks
+// that the keys are strictly ascending.
+func unmarshalEntries[K cmp.Ordered, V any](data []byte, kind byte, keyCodec codec.Codec[K], valueCodec codec.Codec[V]) ([]K, []V, error) {
+	count, payload, err := codec.Open(data, kind)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w", err)
+	}
+
+	// Do not trust the count for the allocation, a corrupted
+	// count must not reserve more than the data can hold
+	size := min(count, uint64(len(payload)))
+	keys := make([]K, 0, size)
+	values := make([]V, 0, size)
+	for i := uint64(0); i < count; i++ {
+		key, n, err := keyCodec.Decode(payload)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w", err)
+		}
+		payload = payload[n:]
+
+		value, n, err := valueCodec.Decode(payload)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w", err)
+		}
+		payload = payload[n:]
+
+		if len(keys) > 0 && keys[len(keys)-1] >= key {
+			return nil, nil, fmt.Errorf("keys are not in ascending order: %v after %v", key, keys[len(keys)-1])
+		}
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+	if len(payload) != 0 {
+		return nil, nil, fmt.Errorf("unexpected trailing data: %v bytes", len(payload))
+	}
+	return keys, values, nil
+}
@@ -0,0 +1,119 @@
+package tree
+
+import (
+	"testing"
+
+	"github.com/dukenmarga/gollection/codec"
+)
+
+func TestAVLTreeMarshalBinary(t *testing.T) {
+	tests := []testAVLT[int, string]{
+		{
+			name: "Test marshal tree with several nodes",
+			inputKeys: []int{
+				5, 6, 2, 10, 12, 3, 1, 9,
+			},
+			inputVals: []string{
+				"5", "6", "2", "10", "12", "3", "1", "9",
+			},
+			wantTreeVal: []string{
+				"1", "2", "3", "5", "6", "9", "10", "12",
+			},
+		},
+		{
+			name: "Test marshal tree with one node",
+			inputKeys: []int{
+				5,
+			},
+			inputVals: []string{
+				"5",
+			},
+			wantTreeVal: []string{
+				"5",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := NewAVLTArray(tt.inputKeys, tt.inputVals).MarshalBinary()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			root := NewAVLTRoot(99, "stale")
+			if err := root.UnmarshalBinary(data); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := root.InorderTraversal()
+			if len(got) != len(tt.wantTreeVal) {
+				t.Fatalf("actual length = %v, want length %v", len(got), len(tt.wantTreeVal))
+			}
+			for i, wantVal := range tt.wantTreeVal {
+				if got[i].value != wantVal {
+					t.Errorf("actual = %v, want %v", got[i].value, wantVal)
+				}
+			}
+			for _, node := range got {
+				if node.GetBalance() > 1 || node.GetBalance() < -1 {
+					t.Errorf("node %v is unbalanced", node.key)
+				}
+			}
+		})
+	}
+}
+
+func TestBSTreeMarshalBinary(t *testing.T) {
+	// A degenerate tree is rebuilt balanced
+	keys := []int{1, 2, 3, 4, 5, 6, 7}
+	data, err := NewBSTArray(keys, keys).MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	root := NewBSTRoot(0, 0)
+	if err := root.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := root.LevelOrderTraversal()
+	want := []int{4, 2, 6, 1, 3, 5, 7}
+	if len(got) != len(want) {
+		t.Fatalf("actual length = %v, want length %v", len(got), len(want))
+	}
+	for i, wantVal := range want {
+		if got[i].value != wantVal {
+			t.Errorf("actual = %v, want %v", got[i].value, wantVal)
+		}
+	}
+
+	// Frames of another collection are rejected
+	if err := NewAVLTRoot(0, 0).UnmarshalBinary(data); err == nil {
+		t.Errorf("expected error when unmarshalling a BST frame into an AVL tree")
+	}
+}
+
+func TestAVLTreeUnmarshalBinaryInvalid(t *testing.T) {
+	// Keys written out of order are rejected
+	buf := codec.AppendHeader(nil, codec.KindAVL, 2)
+	for _, key := range []int{2, 1} {
+		buf, _ = codec.Default[int]().Append(buf, key)
+		buf, _ = codec.Default[int]().Append(buf, key)
+	}
+	root := NewAVLTRoot(0, 0)
+	if err := root.UnmarshalBinary(codec.Seal(buf)); err == nil {
+		t.Errorf("expected error for unsorted keys")
+	}
+
+	if root.key != 0 {
+		t.Errorf("tree was modified by a failed unmarshal")
+	}
+
+	// An empty frame leaves an empty root
+	empty := codec.Seal(codec.AppendHeader(nil, codec.KindAVL, 0))
+	if err := root.UnmarshalBinary(empty); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !root.IsEmpty() {
+		t.Errorf("tree is not empty after unmarshalling an empty frame")
+	}
+}
@@ -0,0 +1,189 @@
+package tree
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// DuplicateKeyError is returned when decoding a tree whose
+// input contains the same key more than once.
+type DuplicateKeyError[K cmp.Ordered] struct {
+	Key K
+}
+
+func (err *DuplicateKeyError[K]) Error() string {
+	return fmt.Sprintf("duplicate key: %v", err.Key)
+}
+
+// MarshalJSON encodes the tree in ascending key order, as a JSON object
+// when the keys are strings and as an array of {"key", "value"} pairs
+// otherwise.
+func (tree *AVLTree[K, V]) MarshalJSON() ([]byte, error) {
+	nodes := tree.InorderTraversal()
+	entries := make([]Entry[K, V], len(nodes))
+	for i, node := range nodes {
+		entries[i] = Entry[K, V]{Key: node.key, Value: node.value}
+	}
+	return marshalJSONEntries(entries)
+}
+
+// UnmarshalJSON replaces the tree with the entries of a JSON object
+// or array of {"key", "value"} pairs. Entries are added in the order
+// they appear, and a key appearing twice returns a *DuplicateKeyError.
+func (tree *AVLTree[K, V]) UnmarshalJSON(data []byte) error {
+	entries, err := unmarshalJSONEntries[K, V](data)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if len(entries) == 0 {
+		*tree = AVLTree[K, V]{}
+		return nil
+	}
+
+	// Sorted input, as written by MarshalJSON, is built in O(n)
+	if keys, values, ok := sortedEntries(entries); ok {
+		*tree = *buildAVLTree(keys, values)
+		return nil
+	}
+
+	root := NewAVLTRoot(entries[0].Key, entries[0].Value)
+	for _, entry := range entries[1:] {
+		if _, err := root.Find(entry.Key); err == nil {
+			return &DuplicateKeyError[K]{Key: entry.Key}
+		}
+		_ = root.Add(entry.Key, entry.Value)
+	}
+	*tree = *root
+	return nil
+}
+
+// MarshalJSON encodes the tree in ascending key order, as a JSON object
+// when the keys are strings and as an array of {"key", "value"} pairs
+// otherwise.
+func (tree *BinarySearchTree[K, V]) MarshalJSON() ([]byte, error) {
+	nodes := tree.InorderTraversal()
+	entries := make([]Entry[K, V], len(nodes))
+	for i, node := range nodes {
+		entries[i] = Entry[K, V]{Key: node.key, Value: node.value}
+	}
+	return marshalJSONEntries(entries)
+}
+
+// UnmarshalJSON replaces the tree with the entries of a JSON object
+// or array of {"key", "value"} pairs. Entries are added in the order
+// they appear, and a key appearing twice returns a *DuplicateKeyError.
+func (tree *BinarySearchTree[K, V]) UnmarshalJSON(data []byte) error {
+	entries, err := unmarshalJSONEntries[K, V](data)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if len(entries) == 0 {
+		*tree = BinarySearchTree[K, V]{}
+		return nil
+	}
+
+	// Sorted input, as written by MarshalJSON, is built
+	// balanced in O(n) instead of as a degenerate list
+	if keys, values, ok := sortedEntries(entries); ok {
+		*tree = *buildBSTree(keys, values)
+		return nil
+	}
+
+	root := NewBSTRoot(entries[0].Key, entries[0].Value)
+	for _, entry := range entries[1:] {
+		if _, err := root.Find(entry.Key); err == nil {
+			return &DuplicateKeyError[K]{Key: entry.Key}
+		}
+		_ = root.Add(entry.Key, entry.Value)
+	}
+	*tree = *root
+	return nil
+}
+
+// isStringKey reports whether the keys are encoded as JSON object names.
+func isStringKey[K cmp.Ordered]() bool {
+	return reflect.TypeFor[K]().Kind() == reflect.String
+}
+
+func marshalJSONEntries[K cmp.Ordered, V any](entries []Entry[K, V]) ([]byte, error) {
+	if !isStringKey[K]() {
+		return json.Marshal(entries)
+	}
+
+	// Write the object by hand, a map would lose the key order
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, entry := range entries {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(reflect.ValueOf(entry.Key).String())
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		value, err := json.Marshal(entry.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func unmarshalJSONEntries[K cmp.Ordered, V any](data []byte) ([]Entry[K, V], error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '{' {
+		var entries []Entry[K, V]
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return entries, nil
+	}
+	if !isStringKey[K]() {
+		return nil, fmt.Errorf("cannot decode JSON object into tree with %v keys", reflect.TypeFor[K]())
+	}
+
+	// Walk the object token by token to keep the order
+	// and to see duplicate names
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if _, err := decoder.Token(); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	var entries []Entry[K, V]
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		var entry Entry[K, V]
+		reflect.ValueOf(&entry.Key).Elem().SetString(token.(string))
+		if err := decoder.Decode(&entry.Value); err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return entries, nil
+}
+
+// sortedEntries splits the entries into keys and values and reports
+// whether the keys are strictly ascending.
+func sortedEntries[K cmp.Ordered, V any](entries []Entry[K, V]) ([]K, []V, bool) {
+	keys := make([]K, len(entries))
+	values := make([]V, len(entries))
+	for i, entry := range entries {
+		if i > 0 && keys[i-1] >= entry.Key {
+			return nil, nil, false
+		}
+		keys[i], values[i] = entry.Key, entry.Value
+	}
+	return keys, values, true
+}
@@ -0,0 +1,156 @@
+package tree
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestAVLTreeMarshalJSON(t *testing.T) {
+	root := NewAVLTArray([]int{5, 2, 8}, []string{"five", "two", "eight"})
+	got, err := json.Marshal(root)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `[{"key":2,"value":"two"},{"key":5,"value":"five"},{"key":8,"value":"eight"}]`
+	if string(got) != want {
+		t.Errorf("actual = %s, want %s", got, want)
+	}
+
+	stringRoot := NewAVLTArray([]string{"b", "a", "c"}, []int{2, 1, 3})
+	got, err = json.Marshal(stringRoot)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want = `{"a":1,"b":2,"c":3}`
+	if string(got) != want {
+		t.Errorf("actual = %s, want %s", got, want)
+	}
+}
+
+type testTreeUnmarshalJSON[K comparable, V any] struct {
+	name        string
+	input       string
+	wantError   bool
+	wantDupKey  bool
+	wantTreeKey []K
+}
+
+func TestAVLTreeUnmarshalJSON(t *testing.T) {
+	tests := []testTreeUnmarshalJSON[string, int]{
+		{
+			name:        "Test unmarshal object",
+			input:       `{"b": 2, "a": 1, "c": 3}`,
+			wantTreeKey: []string{"a", "b", "c"},
+		},
+		{
+			name:        "Test unmarshal array of pairs",
+			input:       `[{"key": "a", "value": 1}, {"key": "b", "value": 2}]`,
+			wantTreeKey: []string{"a", "b"},
+		},
+		{
+			name:       "Test unmarshal object with duplicate names",
+			input:      `{"b": 2, "a": 1, "b": 3}`,
+			wantError:  true,
+			wantDupKey: true,
+		},
+		{
+			name:      "Test unmarshal invalid value",
+			input:     `{"a": "one"}`,
+			wantError: true,
+		},
+		{
+			name:        "Test unmarshal empty object",
+			input:       `{}`,
+			wantTreeKey: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := NewAVLTRoot("stale", 0)
+			err := json.Unmarshal([]byte(tt.input), root)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("actual error = %v, want error %v", err, tt.wantError)
+			}
+			var dupErr *DuplicateKeyError[string]
+			if errors.As(err, &dupErr) != tt.wantDupKey {
+				t.Errorf("actual error = %v, want duplicate key error %v", err, tt.wantDupKey)
+			}
+			if tt.wantError {
+				return
+			}
+
+			got := root.InorderTraversal()
+			if len(got) != len(tt.wantTreeKey) {
+				t.Fatalf("actual length = %v, want length %v", len(got), len(tt.wantTreeKey))
+			}
+			for i, wantKey := range tt.wantTreeKey {
+				if got[i].key != wantKey {
+					t.Errorf("actual = %v, want %v", got[i].key, wantKey)
+				}
+			}
+		})
+	}
+}
+
+func TestBSTreeUnmarshalJSON(t *testing.T) {
+	tests := []testTreeUnmarshalJSON[int, string]{
+		{
+			name:        "Test unmarshal sorted array",
+			input:       `[{"key": 1, "value": "a"}, {"key": 2, "value": "b"}, {"key": 3, "value": "c"}]`,
+			wantTreeKey: []int{2, 1, 3},
+		},
+		{
+			name:        "Test unmarshal unsorted array keeps insertion order",
+			input:       `[{"key": 1, "value": "a"}, {"key": 3, "value": "c"}, {"key": 2, "value": "b"}]`,
+			wantTreeKey: []int{1, 3, 2},
+		},
+		{
+			name:       "Test unmarshal array with duplicate keys",
+			input:      `[{"key": 3, "value": "a"}, {"key": 1, "value": "b"}, {"key": 1, "value": "c"}]`,
+			wantError:  true,
+			wantDupKey: true,
+		},
+		{
+			name:      "Test unmarshal object into int keys",
+			input:     `{"1": "a"}`,
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := NewBSTRoot(0, "stale")
+			err := json.Unmarshal([]byte(tt.input), root)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("actual error = %v, want error %v", err, tt.wantError)
+			}
+			var dupErr *DuplicateKeyError[int]
+			if errors.As(err, &dupErr) != tt.wantDupKey {
+				t.Errorf("actual error = %v, want duplicate key error %v", err, tt.wantDupKey)
+			}
+			if tt.wantError {
+				return
+			}
+
+			got := root.LevelOrderTraversal()
+			if len(got) != len(tt.wantTreeKey) {
+				t.Fatalf("actual length = %v, want length %v", len(got), len(tt.wantTreeKey))
+			}
+			for i, wantKey := range tt.wantTreeKey {
+				if got[i].key != wantKey {
+					t.Errorf("actual = %v, want %v", got[i].key, wantKey)
+				}
+			}
+
+			// Round trip
+			data, _ := json.Marshal(root)
+			again := NewBSTRoot(0, "")
+			if err := json.Unmarshal(data, again); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(again.InorderTraversal()) != len(got) {
+				t.Errorf("round trip lost entries")
+			}
+		})
+	}
+}
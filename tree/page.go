@@ -0,0 +1,142 @@
+package tree
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Page returns up to limit entries with keys strictly greater than
+// after, in ascending order. next is the key to pass as after to get
+// the following page, and more reports whether there are entries past
+// this page. Only the path to after and the returned entries are
+// visited, so a page costs O(log n + limit) on a balanced tree.
+// If the page is empty, next is after itself.
+func (tree *AVLTree[K, V]) Page(after K, limit int) ([]Entry[K, V], K, bool) {
+	page, more := pageEntries[K, V](tree, &after, limit, false)
+	return page, pageNext(page, after, false), more
+}
+
+// PageBefore returns up to limit entries with keys strictly smaller
+// than before, that is the page preceding before, in ascending order.
+// next is the key to pass as before to get the previous page, and more
+// reports whether there are entries before this page.
+// If the page is empty, next is before itself.
+func (tree *AVLTree[K, V]) PageBefore(before K, limit int) ([]Entry[K, V], K, bool) {
+	page, more := pageEntries[K, V](tree, &before, limit, true)
+	return page, pageNext(page, before, true), more
+}
+
+// PageFirst returns the first page of up to limit entries, see Page.
+func (tree *AVLTree[K, V]) PageFirst(limit int) ([]Entry[K, V], K, bool) {
+	page, more := pageEntries[K, V](tree, nil, limit, false)
+	var empty K
+	return page, pageNext(page, empty, false), more
+}
+
+// PageLast returns the last page of up to limit entries, see PageBefore.
+func (tree *AVLTree[K, V]) PageLast(limit int) ([]Entry[K, V], K, bool) {
+	page, more := pageEntries[K, V](tree, nil, limit, true)
+	var empty K
+	return page, pageNext(page, empty, true), more
+}
+
+// Page returns up to limit entries with keys strictly greater than
+// after, in ascending order. next is the key to pass as after to get
+// the following page, and more reports whether there are entries past
+// this page. Only the path to after and the returned entries are
+// visited, so a page costs O(h + limit) where h is the height.
+// If the page is empty, next is after itself.
+func (tree *BinarySearchTree[K, V]) Page(after K, limit int) ([]Entry[K, V], K, bool) {
+	page, more := pageEntries[K, V](tree, &after, limit, false)
+	return page, pageNext(page, after, false), more
+}
+
+// PageBefore returns up to limit entries with keys strictly smaller
+// than before, that is the page preceding before, in ascending order.
+// next is the key to pass as before to get the previous page, and more
+// reports whether there are entries before this page.
+// If the page is empty, next is before itself.
+func (tree *BinarySearchTree[K, V]) PageBefore(before K, limit int) ([]Entry[K, V], K, bool) {
+	page, more := pageEntries[K, V](tree, &before, limit, true)
+	return page, pageNext(page, before, true), more
+}
+
+// PageFirst returns the first page of up to limit entries, see Page.
+func (tree *BinarySearchTree[K, V]) PageFirst(limit int) ([]Entry[K, V], K, bool) {
+	page, more := pageEntries[K, V](tree, nil, limit, false)
+	var empty K
+	return page, pageNext(page, empty, false), more
+}
+
+// PageLast returns the last page of up to limit entries, see PageBefore.
+func (tree *BinarySearchTree[K, V]) PageLast(limit int) ([]Entry[K, V], K, bool) {
+	page, more := pageEntries[K, V](tree, nil, limit, true)
+	var empty K
+	return page, pageNext(page, empty, true), more
+}
+
+// pageEntries collects up to limit entries beyond bound (nil means
+// unbounded) with an iterative in-order walk. The stack holds the
+// nodes whose entry is still to be returned, so the walk stops as soon
+// as the page is full and more is whether the stack is not empty.
+// Backward walks in descending order, the page is then reversed.
+func pageEntries[K cmp.Ordered, V any, N validateNode[K, V, N]](root N, bound *K, limit int, backward bool) ([]Entry[K, V], bool) {
+	var (
+		empty N
+		stack []N
+	)
+	// descend pushes the nodes in range on the way to the first entry
+	// of the subtree, skipping the subtrees that are out of range
+	descend := func(node N) {
+		for node != empty {
+			entry := node.treeNode()
+			if entry == nil {
+				return
+			}
+			near, far := node.children()
+			if backward {
+				near, far = far, near
+			}
+			if bound == nil || (!backward && entry.key > *bound) || (backward && entry.key < *bound) {
+				stack = append(stack, node)
+				node = near
+			} else {
+				node = far
+			}
+		}
+	}
+	descend(root)
+
+	page := []Entry[K, V]{}
+	for len(page) < limit && len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		entry := node.treeNode()
+		page = append(page, Entry[K, V]{Key: entry.key, Value: entry.value})
+
+		left, right := node.children()
+		if backward {
+			descend(left)
+		} else {
+			descend(right)
+		}
+	}
+
+	if backward {
+		slices.Reverse(page)
+	}
+	return page, len(stack) > 0
+}
+
+// pageNext returns the key to continue from: the last key of a forward
+// page, the first key of a backward page or fallback if the page is empty.
+func pageNext[K cmp.Ordered, V any](page []Entry[K, V], fallback K, backward bool) K {
+	if len(page) == 0 {
+		return fallback
+	}
+	if backward {
+		return page[0].Key
+	}
+	return page[len(page)-1].Key
+}
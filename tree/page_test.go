@@ -0,0 +1,167 @@
+package tree
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+type testPage[K any] struct {
+	name     string
+	from     K
+	limit    int
+	backward bool
+	wantKeys []K
+	wantNext K
+	wantMore bool
+}
+
+func pageKeys[K cmp.Ordered, V any](page []Entry[K, V]) []K {
+	keys := []K{}
+	for _, entry := range page {
+		keys = append(keys, entry.Key)
+	}
+	return keys
+}
+
+func TestPage(t *testing.T) {
+	keys := []int{10, 20, 30, 40, 50}
+	avl := NewAVLTArray(keys, keys)
+	bst := NewBSTArray(keys, keys)
+	tests := []testPage[int]{
+		{
+			name:     "Test page: after existing key",
+			from:     20,
+			limit:    2,
+			wantKeys: []int{30, 40},
+			wantNext: 40,
+			wantMore: true,
+		},
+		{
+			name:     "Test page: after missing key",
+			from:     25,
+			limit:    5,
+			wantKeys: []int{30, 40, 50},
+			wantNext: 50,
+			wantMore: false,
+		},
+		{
+			name:     "Test page: exactly the rest",
+			from:     30,
+			limit:    2,
+			wantKeys: []int{40, 50},
+			wantNext: 50,
+			wantMore: false,
+		},
+		{
+			name:     "Test page: after the last key",
+			from:     50,
+			limit:    2,
+			wantKeys: []int{},
+			wantNext: 50,
+			wantMore: false,
+		},
+		{
+			name:     "Test page: zero limit",
+			from:     0,
+			limit:    0,
+			wantKeys: []int{},
+			wantNext: 0,
+			wantMore: true,
+		},
+		{
+			name:     "Test page before: existing key",
+			from:     40,
+			limit:    2,
+			backward: true,
+			wantKeys: []int{20, 30},
+			wantNext: 20,
+			wantMore: true,
+		},
+		{
+			name:     "Test page before: missing key",
+			from:     35,
+			limit:    5,
+			backward: true,
+			wantKeys: []int{10, 20, 30},
+			wantNext: 10,
+			wantMore: false,
+		},
+		{
+			name:     "Test page before: the first key",
+			from:     10,
+			limit:    2,
+			backward: true,
+			wantKeys: []int{},
+			wantNext: 10,
+			wantMore: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pageAVL, pageBST := avl.Page, bst.Page
+			if tt.backward {
+				pageAVL, pageBST = avl.PageBefore, bst.PageBefore
+			}
+			for kind, page := range map[string]func(int, int) ([]Entry[int, int], int, bool){"AVL": pageAVL, "BST": pageBST} {
+				entries, next, more := page(tt.from, tt.limit)
+				if got := pageKeys(entries); !slices.Equal(got, tt.wantKeys) {
+					t.Errorf("%v: actual = %v, want %v", kind, got, tt.wantKeys)
+				}
+				if next != tt.wantNext {
+					t.Errorf("%v: actual next = %v, want %v", kind, next, tt.wantNext)
+				}
+				if more != tt.wantMore {
+					t.Errorf("%v: actual more = %v, want %v", kind, more, tt.wantMore)
+				}
+			}
+		})
+	}
+}
+
+func TestPageWalk(t *testing.T) {
+	keys := []int{5, 6, 2, 10, 12, 3, 1, 9, 7}
+	root := NewAVLTArray(keys, keys)
+	sorted := slices.Sorted(slices.Values(keys))
+
+	for limit := 1; limit <= len(keys)+1; limit++ {
+		var forward []int
+		page, next, more := root.PageFirst(limit)
+		forward = append(forward, pageKeys(page)...)
+		for more {
+			page, next, more = root.Page(next, limit)
+			forward = append(forward, pageKeys(page)...)
+		}
+		if !slices.Equal(forward, sorted) {
+			t.Errorf("limit %v: actual forward = %v, want %v", limit, forward, sorted)
+		}
+
+		var backward []int
+		page, next, more = root.PageLast(limit)
+		backward = append(pageKeys(page), backward...)
+		for more {
+			page, next, more = root.PageBefore(next, limit)
+			backward = append(pageKeys(page), backward...)
+		}
+		if !slices.Equal(backward, sorted) {
+			t.Errorf("limit %v: actual backward = %v, want %v", limit, backward, sorted)
+		}
+	}
+}
+
+func TestPageEmpty(t *testing.T) {
+	root := NewAVLTRoot(1, 1)
+	if _, err := root.Delete(1); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	page, next, more := root.PageFirst(3)
+	if len(page) != 0 || next != 0 || more {
+		t.Errorf("actual = %v, %v, %v, want [], 0, false", page, next, more)
+	}
+
+	var bst *BinarySearchTree[int, int]
+	page, next, more = bst.PageBefore(5, 3)
+	if len(page) != 0 || next != 5 || more {
+		t.Errorf("actual = %v, %v, %v, want [], 5, false", page, next, more)
+	}
+}
@@ -0,0 +1,223 @@
+package tree
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// RadixTree is a compressed trie keyed by string. Every node stores the
+// part of the key (prefix) on the edge from its parent, and children are
+// kept sorted by their first byte so iteration visits keys in the same
+// ascending byte order as the in-order traversal of an AVLTree[string, V].
+// The root always has an empty prefix and the empty string is a valid key.
+type RadixTree[V any] struct {
+	prefix   string
+	value    V
+	leaf     bool
+	children []*RadixTree[V]
+}
+
+func NewRadixTree[V any]() *RadixTree[V] {
+	return &RadixTree[V]{}
+}
+
+func NewRadixTreeArray[V any](keys []string, values []V) *RadixTree[V] {
+	root := NewRadixTree[V]()
+	for i := 0; i < len(keys) && i < len(values); i++ {
+		root.Put(keys[i], values[i])
+	}
+	return root
+}
+
+// All iterates over every key and value in ascending key order.
+func (tree *RadixTree[V]) All() iter.Seq2[string, V] {
+	return tree.WalkPrefix("")
+}
+
+// Delete removes the key from the tree. Nodes left without a value are
+// removed or merged with their only child to keep the tree compressed.
+func (tree *RadixTree[V]) Delete(key string) error {
+	if key == "" {
+		if !tree.leaf {
+			return fmt.Errorf("key not found")
+		}
+		var empty V
+		tree.value = empty
+		tree.leaf = false
+		return nil
+	}
+
+	index, found := tree.search(key[0])
+	if !found {
+		return fmt.Errorf("key not found")
+	}
+	child := tree.children[index]
+	if !strings.HasPrefix(key, child.prefix) {
+		return fmt.Errorf("key not found")
+	}
+
+	err := child.Delete(key[len(child.prefix):])
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	// Compact the child now that it may have lost its value or a child
+	if !child.leaf && len(child.children) == 0 {
+		tree.children = slices.Delete(tree.children, index, index+1)
+	} else if !child.leaf && len(child.children) == 1 {
+		grandChild := child.children[0]
+		grandChild.prefix = child.prefix + grandChild.prefix
+		tree.children[index] = grandChild
+	}
+	return nil
+}
+
+func (tree *RadixTree[V]) Get(key string) (V, error) {
+	node := tree
+	for key != "" {
+		index, found := node.search(key[0])
+		if !found || !strings.HasPrefix(key, node.children[index].prefix) {
+			var empty V
+			return empty, fmt.Errorf("key not found")
+		}
+		node = node.children[index]
+		key = key[len(node.prefix):]
+	}
+	if !node.leaf {
+		var empty V
+		return empty, fmt.Errorf("key not found")
+	}
+	return node.value, nil
+}
+
+func (tree *RadixTree[V]) IsEmpty() bool {
+	return !tree.leaf && len(tree.children) == 0
+}
+
+// LongestPrefix returns the longest key in the tree that is a prefix of s,
+// together with its value.
+func (tree *RadixTree[V]) LongestPrefix(s string) (string, V, error) {
+	var (
+		matchKey   string
+		matchValue V
+		found      bool
+	)
+
+	node := tree
+	walked := 0
+	for {
+		if node.leaf {
+			matchKey, matchValue, found = s[:walked], node.value, true
+		}
+		if walked == len(s) {
+			break
+		}
+		index, ok := node.search(s[walked])
+		if !ok || !strings.HasPrefix(s[walked:], node.children[index].prefix) {
+			break
+		}
+		node = node.children[index]
+		walked += len(node.prefix)
+	}
+
+	if !found {
+		return "", matchValue, fmt.Errorf("no prefix found")
+	}
+	return matchKey, matchValue, nil
+}
+
+// Put adds the key to the tree, or replaces its value if it already exists.
+// An edge sharing only part of its prefix with the key is split in two.
+func (tree *RadixTree[V]) Put(key string, value V) {
+	node := tree
+	for key != "" {
+		index, found := node.search(key[0])
+		if !found {
+			newNode := &RadixTree[V]{
+				prefix: key,
+				value:  value,
+				leaf:   true,
+			}
+			node.children = slices.Insert(node.children, index, newNode)
+			return
+		}
+
+		child := node.children[index]
+		common := commonPrefixLength(key, child.prefix)
+		if common < len(child.prefix) {
+			// Split the edge at the end of the common prefix
+			split := &RadixTree[V]{
+				prefix:   child.prefix[:common],
+				children: []*RadixTree[V]{child},
+			}
+			child.prefix = child.prefix[common:]
+			node.children[index] = split
+			child = split
+		}
+
+		node = child
+		key = key[common:]
+	}
+	node.value = value
+	node.leaf = true
+}
+
+// WalkPrefix iterates, in ascending key order, over every key
+// starting with prefix.
+func (tree *RadixTree[V]) WalkPrefix(prefix string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		node := tree
+		walked := ""
+		for prefix != "" {
+			index, found := node.search(prefix[0])
+			if !found {
+				return
+			}
+			child := node.children[index]
+			switch {
+			case strings.HasPrefix(prefix, child.prefix):
+				prefix = prefix[len(child.prefix):]
+			case strings.HasPrefix(child.prefix, prefix):
+				// The prefix ends in the middle of this edge
+				prefix = ""
+			default:
+				return
+			}
+			node = child
+			walked += child.prefix
+		}
+		node.walk(walked, yield)
+	}
+}
+
+// search returns the position of the child whose prefix starts with b,
+// or the position where such a child should be inserted.
+func (tree *RadixTree[V]) search(b byte) (int, bool) {
+	return slices.BinarySearchFunc(tree.children, b, func(child *RadixTree[V], b byte) int {
+		return int(child.prefix[0]) - int(b)
+	})
+}
+
+func (tree *RadixTree[V]) walk(key string, yield func(string, V) bool) bool {
+	if tree.leaf && !yield(key, tree.value) {
+		return false
+	}
+	for _, child := range tree.children {
+		if !child.walk(key+child.prefix, yield) {
+			return false
+		}
+	}
+	return true
+}
+
+func commonPrefixLength(a, b string) int {
+	n := min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	return n
+}
@@ -0,0 +1,247 @@
+package tree
+
+import (
+	"testing"
+)
+
+type testRadixTree[V any] struct {
+	name      string
+	inputKeys []string
+	inputVals []V
+	deleteKey []string
+	wantError bool
+	query     string
+	wantKeys  []string
+	wantVals  []V
+}
+
+func TestRadixTreeOrderMatchesAVLTree(t *testing.T) {
+	keys := []string{
+		"/api/v1/users", "/api", "/api/v1", "/api/v2/users", "/apis",
+		"", "/", "/a", "/api/v1/user", "zeta", "alpha", "/api/v1/users/me",
+	}
+	values := make([]int, len(keys))
+	for i := range keys {
+		values[i] = i
+	}
+
+	radix := NewRadixTreeArray(keys, values)
+	avl := NewAVLTArray(keys, values)
+
+	want := avl.InorderTraversal()
+	i := 0
+	for key, value := range radix.All() {
+		if i >= len(want) {
+			t.Fatalf("radix tree has more keys than AVL tree")
+		}
+		if key != want[i].key || value != want[i].value {
+			t.Errorf("actual = %v:%v, want %v:%v", key, value, want[i].key, want[i].value)
+		}
+		i++
+	}
+	if i != len(want) {
+		t.Errorf("actual length = %v, want length %v", i, len(want))
+	}
+}
+
+func TestRadixTreeGet(t *testing.T) {
+	tests := []testRadixTree[int]{
+		{
+			name: "Test get: key on split edge",
+			inputKeys: []string{
+				"romane", "romanus", "romulus",
+			},
+			inputVals: []int{
+				1, 2, 3,
+			},
+			query:    "romanus",
+			wantVals: []int{2},
+		},
+		{
+			name: "Test get: key is an inner node without value",
+			inputKeys: []string{
+				"romane", "romanus", "romulus",
+			},
+			inputVals: []int{
+				1, 2, 3,
+			},
+			query:     "roman",
+			wantError: true,
+		},
+		{
+			name: "Test get: replaced value",
+			inputKeys: []string{
+				"romane", "romanus", "romane",
+			},
+			inputVals: []int{
+				1, 2, 3,
+			},
+			query:    "romane",
+			wantVals: []int{3},
+		},
+		{
+			name: "Test get: key is removed",
+			inputKeys: []string{
+				"romane", "romanus", "romulus",
+			},
+			inputVals: []int{
+				1, 2, 3,
+			},
+			deleteKey: []string{
+				"romanus",
+			},
+			query:     "romanus",
+			wantError: true,
+		},
+		{
+			name: "Test get: key under a merged edge",
+			inputKeys: []string{
+				"romane", "romanus", "romulus",
+			},
+			inputVals: []int{
+				1, 2, 3,
+			},
+			deleteKey: []string{
+				"romulus",
+			},
+			query:    "romane",
+			wantVals: []int{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := NewRadixTreeArray(tt.inputKeys, tt.inputVals)
+			for _, key := range tt.deleteKey {
+				if err := root.Delete(key); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			got, err := root.Get(tt.query)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("actual error = %v, want error %v", err, tt.wantError)
+			}
+			if !tt.wantError && got != tt.wantVals[0] {
+				t.Errorf("actual = %v, want %v", got, tt.wantVals[0])
+			}
+		})
+	}
+}
+
+func TestRadixTreeDelete(t *testing.T) {
+	root := NewRadixTreeArray([]string{"a", "ab", "abc"}, []int{1, 2, 3})
+	if err := root.Delete("abcd"); err == nil {
+		t.Errorf("expected error when deleting missing key")
+	}
+	if err := root.Delete("ab"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// "abc" is merged back under "a"
+	if len(root.children) != 1 || len(root.children[0].children) != 1 {
+		t.Fatalf("unexpected tree shape after delete")
+	}
+	if got := root.children[0].children[0].prefix; got != "bc" {
+		t.Errorf("actual prefix = %v, want %v", got, "bc")
+	}
+
+	_ = root.Delete("a")
+	_ = root.Delete("abc")
+	if !root.IsEmpty() {
+		t.Errorf("tree is not empty after deleting every key")
+	}
+}
+
+func TestRadixTreeLongestPrefix(t *testing.T) {
+	keys := []string{
+		"/", "/api", "/api/v1", "/api/v1/users",
+	}
+	values := []int{
+		1, 2, 3, 4,
+	}
+	tests := []testRadixTree[int]{
+		{
+			name:     "Test longest prefix: exact key",
+			query:    "/api/v1",
+			wantKeys: []string{"/api/v1"},
+			wantVals: []int{3},
+		},
+		{
+			name:     "Test longest prefix: inside an edge",
+			query:    "/api/v1/use",
+			wantKeys: []string{"/api/v1"},
+			wantVals: []int{3},
+		},
+		{
+			name:     "Test longest prefix: diverging path",
+			query:    "/apx",
+			wantKeys: []string{"/"},
+			wantVals: []int{1},
+		},
+		{
+			name:      "Test longest prefix: no prefix",
+			query:     "api",
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := NewRadixTreeArray(keys, values)
+
+			key, value, err := root.LongestPrefix(tt.query)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("actual error = %v, want error %v", err, tt.wantError)
+			}
+			if tt.wantError {
+				return
+			}
+			if key != tt.wantKeys[0] || value != tt.wantVals[0] {
+				t.Errorf("actual = %v:%v, want %v:%v", key, value, tt.wantKeys[0], tt.wantVals[0])
+			}
+		})
+	}
+}
+
+func TestRadixTreeWalkPrefix(t *testing.T) {
+	keys := []string{
+		"/api/v1/users", "/api/v1/groups", "/api/v2/users", "/apis", "/b",
+	}
+	values := []int{
+		1, 2, 3, 4, 5,
+	}
+	tests := []testRadixTree[int]{
+		{
+			name:     "Test walk prefix: prefix ends on a node",
+			query:    "/api/v1/",
+			wantKeys: []string{"/api/v1/groups", "/api/v1/users"},
+		},
+		{
+			name:     "Test walk prefix: prefix ends inside an edge",
+			query:    "/ap",
+			wantKeys: []string{"/api/v1/groups", "/api/v1/users", "/api/v2/users", "/apis"},
+		},
+		{
+			name:     "Test walk prefix: no match",
+			query:    "/c",
+			wantKeys: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := NewRadixTreeArray(keys, values)
+
+			got := []string{}
+			for key := range root.WalkPrefix(tt.query) {
+				got = append(got, key)
+			}
+			if len(got) != len(tt.wantKeys) {
+				t.Fatalf("actual = %v, want %v", got, tt.wantKeys)
+			}
+			for i, wantKey := range tt.wantKeys {
+				if got[i] != wantKey {
+					t.Errorf("actual = %v, want %v", got[i], wantKey)
+				}
+			}
+		})
+	}
+}
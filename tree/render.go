@@ -0,0 +1,220 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// renderNode is what the renderers need from a tree node.
+// A zero N is an empty subtree, and a root whose last key
+// was deleted reports IsEmpty.
+type renderNode[N any] interface {
+	comparable
+	IsEmpty() bool
+	children() (N, N)
+	dotLabel() string
+	prettyLabel() string
+}
+
+// Pretty writes the tree sideways: the root is on the left, right
+// subtrees are drawn above their parent and left subtrees below.
+func (tree *AVLTree[K, V]) Pretty(w io.Writer) error {
+	return writePretty(w, tree)
+}
+
+// Format implements fmt.Formatter. %v and %s print the tree as drawn
+// by Pretty. Any other verb prints the entries in ascending key order
+// like a map, with every key and value formatted using the same verb
+// and flags, e.g. [1:0x1 2:0x2] for %#x.
+func (tree *AVLTree[K, V]) Format(f fmt.State, verb rune) {
+	if verb == 'v' || verb == 's' {
+		fmt.Fprint(f, tree.String())
+		return
+	}
+	formatEntries(f, verb, tree.walkInorder)
+}
+
+// String returns the tree as drawn by Pretty.
+func (tree *AVLTree[K, V]) String() string {
+	var sb strings.Builder
+	_ = tree.Pretty(&sb)
+	return sb.String()
+}
+
+// WriteDOT writes the tree in the Graphviz DOT language. Every node is
+// annotated with its height (h) and balance factor (bf).
+func (tree *AVLTree[K, V]) WriteDOT(w io.Writer) error {
+	return writeDOT(w, "AVLTree", tree)
+}
+
+func (tree *AVLTree[K, V]) children() (*AVLTree[K, V], *AVLTree[K, V]) {
+	return tree.left, tree.right
+}
+
+func (tree *AVLTree[K, V]) dotLabel() string {
+	return fmt.Sprintf("%v: %v\nh=%v bf=%v", tree.key, tree.value, tree.Height(), tree.GetBalance())
+}
+
+func (tree *AVLTree[K, V]) prettyLabel() string {
+	return fmt.Sprintf("%v: %v", tree.key, tree.value)
+}
+
+// Pretty writes the tree sideways: the root is on the left, right
+// subtrees are drawn above their parent and left subtrees below.
+func (tree *BinarySearchTree[K, V]) Pretty(w io.Writer) error {
+	return writePretty(w, tree)
+}
+
+// Format implements fmt.Formatter. %v and %s print the tree as drawn
+// by Pretty. Any other verb prints the entries in ascending key order
+// like a map, with every key and value formatted using the same verb
+// and flags, e.g. [1:0x1 2:0x2] for %#x.
+func (tree *BinarySearchTree[K, V]) Format(f fmt.State, verb rune) {
+	if verb == 'v' || verb == 's' {
+		fmt.Fprint(f, tree.String())
+		return
+	}
+	formatEntries(f, verb, tree.walkInorder)
+}
+
+// String returns the tree as drawn by Pretty.
+func (tree *BinarySearchTree[K, V]) String() string {
+	var sb strings.Builder
+	_ = tree.Pretty(&sb)
+	return sb.String()
+}
+
+// WriteDOT writes the tree in the Graphviz DOT language. Every node is
+// annotated with its height (h) and balance factor (bf).
+func (tree *BinarySearchTree[K, V]) WriteDOT(w io.Writer) error {
+	return writeDOT(w, "BinarySearchTree", tree)
+}
+
+func (tree *BinarySearchTree[K, V]) children() (*BinarySearchTree[K, V], *BinarySearchTree[K, V]) {
+	return tree.left, tree.right
+}
+
+func (tree *BinarySearchTree[K, V]) dotLabel() string {
+	balance := tree.left.Height() - tree.right.Height()
+	return fmt.Sprintf("%v: %v\nh=%v bf=%v", tree.key, tree.value, tree.Height(), balance)
+}
+
+func (tree *BinarySearchTree[K, V]) prettyLabel() string {
+	return fmt.Sprintf("%v: %v", tree.key, tree.value)
+}
+
+// renderWriter keeps the first write error so the
+// renderers do not have to check every write.
+type renderWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (rw *renderWriter) printf(format string, args ...any) {
+	if rw.err != nil {
+		return
+	}
+	_, rw.err = fmt.Fprintf(rw.w, format, args...)
+}
+
+// formatEntries prints the entries as key:value pairs in ascending key
+// order, with the key and value formatted using the verb and flags of f.
+func formatEntries[K cmp.Ordered, V any, N validateNode[K, V, N]](f fmt.State, verb rune, walk func(func(N) bool) bool) {
+	format := fmt.FormatString(f, verb)
+	fmt.Fprint(f, "[")
+	first := true
+	walk(func(node N) bool {
+		if !first {
+			fmt.Fprint(f, " ")
+		}
+		first = false
+		entry := node.treeNode()
+		fmt.Fprintf(f, format+":"+format, entry.key, entry.value)
+		return true
+	})
+	fmt.Fprint(f, "]")
+}
+
+func writePretty[N renderNode[N]](w io.Writer, root N) error {
+	if root.IsEmpty() {
+		return nil
+	}
+	rw := &renderWriter{w: w}
+	prettyNode(rw, root, "", "")
+	return rw.err
+}
+
+// prettyNode writes the right subtree, the node and then the left
+// subtree. The prefixes continue the vertical lines of the ancestors,
+// and connector joins the node to its parent.
+func prettyNode[N renderNode[N]](rw *renderWriter, node N, prefix, connector string) {
+	var empty N
+	left, right := node.children()
+
+	// A vertical line is only needed on the side facing the parent
+	abovePrefix, belowPrefix := prefix, prefix
+	switch connector {
+	case "┌── ":
+		abovePrefix += "    "
+		belowPrefix += "│   "
+	case "└── ":
+		abovePrefix += "│   "
+		belowPrefix += "    "
+	}
+
+	if right != empty {
+		prettyNode(rw, right, abovePrefix, "┌── ")
+	}
+	rw.printf("%s%s%s\n", prefix, connector, node.prettyLabel())
+	if left != empty {
+		prettyNode(rw, left, belowPrefix, "└── ")
+	}
+}
+
+func writeDOT[N renderNode[N]](w io.Writer, name string, root N) error {
+	rw := &renderWriter{w: w}
+	rw.printf("digraph %s {\n", name)
+	rw.printf("\tnode [shape=box];\n")
+
+	if !root.IsEmpty() {
+		id := 0
+		dotNode(rw, root, &id)
+	}
+
+	rw.printf("}\n")
+	return rw.err
+}
+
+// dotNode writes the node and the edges to its children, numbering the
+// nodes in pre-order. A missing child next to an existing one is drawn as
+// an invisible point so Graphviz keeps left and right children apart.
+func dotNode[N renderNode[N]](rw *renderWriter, node N, id *int) {
+	var empty N
+	self := *id
+	*id++
+	rw.printf("\tn%d [label=\"%s\"];\n", self, dotEscape(node.dotLabel()))
+
+	left, right := node.children()
+	if left == empty && right == empty {
+		return
+	}
+	for _, child := range []N{left, right} {
+		childID := *id
+		if child == empty {
+			*id++
+			rw.printf("\tn%d [shape=point, style=invis];\n", childID)
+			rw.printf("\tn%d -> n%d [style=invis];\n", self, childID)
+			continue
+		}
+		rw.printf("\tn%d -> n%d;\n", self, childID)
+		dotNode(rw, child, id)
+	}
+}
+
+// dotEscape escapes a label for a DOT double-quoted string,
+// turning new lines into centered line breaks.
+func dotEscape(label string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(label)
+}
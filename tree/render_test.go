@@ -0,0 +1,149 @@
+package tree
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestAVLTreePretty(t *testing.T) {
+	tests := []struct {
+		name      string
+		inputKeys []int
+		want      string
+	}{
+		{
+			name:      "Test pretty balanced tree",
+			inputKeys: []int{4, 2, 6, 1, 3, 5, 7},
+			want: "" +
+				"    ┌── 7: 7\n" +
+				"┌── 6: 6\n" +
+				"│   └── 5: 5\n" +
+				"4: 4\n" +
+				"│   ┌── 3: 3\n" +
+				"└── 2: 2\n" +
+				"    └── 1: 1\n",
+		},
+		{
+			name:      "Test pretty tree with one child",
+			inputKeys: []int{2, 1},
+			want: "" +
+				"2: 2\n" +
+				"└── 1: 1\n",
+		},
+		{
+			name:      "Test pretty empty tree",
+			inputKeys: []int{},
+			want:      "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := NewAVLTArray(tt.inputKeys, tt.inputKeys)
+			if got := root.String(); got != tt.want {
+				t.Errorf("actual =\n%v\nwant\n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBSTreePretty(t *testing.T) {
+	root := NewBSTArray([]int{1, 3, 2}, []string{"a", "c", "b"})
+	want := "" +
+		"┌── 3: c\n" +
+		"│   └── 2: b\n" +
+		"1: a\n"
+	if got := root.String(); got != want {
+		t.Errorf("actual =\n%v\nwant\n%v", got, want)
+	}
+}
+
+type testTreeFormat struct {
+	name  string
+	input fmt.Formatter
+	fmt   string
+	want  string
+}
+
+func TestTreeFormat(t *testing.T) {
+	avl := NewAVLTArray([]int{2, 1, 3}, []int{20, 10, 30})
+	bst := NewBSTArray([]string{"b", "a"}, []string{"x", "y"})
+	tests := []testTreeFormat{
+		{
+			name:  "Test format: %v draws the tree",
+			input: avl,
+			fmt:   "%v",
+			want:  avl.String(),
+		},
+		{
+			name:  "Test format: %s draws the tree",
+			input: bst,
+			fmt:   "%s",
+			want:  bst.String(),
+		},
+		{
+			name:  "Test format: %d prints the entries",
+			input: avl,
+			fmt:   "%d",
+			want:  "[1:10 2:20 3:30]",
+		},
+		{
+			name:  "Test format: flags apply to keys and values",
+			input: avl,
+			fmt:   "%#x",
+			want:  "[0x1:0xa 0x2:0x14 0x3:0x1e]",
+		},
+		{
+			name:  "Test format: %q on a binary search tree",
+			input: bst,
+			fmt:   "%q",
+			want:  `["a":"y" "b":"x"]`,
+		},
+		{
+			name:  "Test format: empty tree",
+			input: (*AVLTree[int, int])(nil),
+			fmt:   "%d",
+			want:  "[]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.fmt, tt.input); got != tt.want {
+				t.Errorf("actual = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteDOT(t *testing.T) {
+	var sb strings.Builder
+	root := NewBSTArray([]int{2, 1, 3, 4}, []string{"b", "a", "c", `"d"`})
+	if err := root.WriteDOT(&sb); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "" +
+		"digraph BinarySearchTree {\n" +
+		"\tnode [shape=box];\n" +
+		"\tn0 [label=\"2: b\\nh=2 bf=-1\"];\n" +
+		"\tn0 -> n1;\n" +
+		"\tn1 [label=\"1: a\\nh=0 bf=0\"];\n" +
+		"\tn0 -> n2;\n" +
+		"\tn2 [label=\"3: c\\nh=1 bf=-1\"];\n" +
+		"\tn3 [shape=point, style=invis];\n" +
+		"\tn2 -> n3 [style=invis];\n" +
+		"\tn2 -> n4;\n" +
+		"\tn4 [label=\"4: \\\"d\\\"\\nh=0 bf=0\"];\n" +
+		"}\n"
+	if got := sb.String(); got != want {
+		t.Errorf("actual =\n%v\nwant\n%v", got, want)
+	}
+
+	sb.Reset()
+	avl := NewAVLTArray([]int{1, 2, 3}, []int{1, 2, 3})
+	if err := avl.WriteDOT(&sb); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(sb.String(), "digraph AVLTree {") || !strings.Contains(sb.String(), `2: 2\nh=1 bf=0`) {
+		t.Errorf("actual =\n%v", sb.String())
+	}
+}
@@ -0,0 +1,165 @@
+package tree
+
+import (
+	"cmp"
+	"encoding/gob"
+	"fmt"
+	"io"
+
+	"github.com/dukenmarga/gollection/codec"
+)
+
+// RegisterGob registers the tree type with encoding/gob, which is only
+// needed when the tree is sent as the value of an interface field.
+func RegisterGob[K cmp.Ordered, V any]() {
+	gob.Register(&AVLTree[K, V]{})
+}
+
+// GobDecode implements gob.GobDecoder using UnmarshalBinary.
+func (tree *AVLTree[K, V]) GobDecode(data []byte) error {
+	return tree.UnmarshalBinary(data)
+}
+
+// GobEncode implements gob.GobEncoder using MarshalBinary.
+func (tree *AVLTree[K, V]) GobEncode() ([]byte, error) {
+	return tree.MarshalBinary()
+}
+
+// ReadFrom replaces the tree with a stream written by WriteTo,
+// using the default codecs.
+func (tree *AVLTree[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	return tree.ReadFromWith(r, codec.Default[K](), codec.Default[V]())
+}
+
+// ReadFromWith replaces the tree with a stream written by WriteToWith
+// using the same codecs. Since the entries arrive in ascending key order
+// and their count is known upfront, the balanced tree is built while
+// reading, in O(n) and without buffering the entries.
+// The tree is left untouched when the stream is invalid.
+func (tree *AVLTree[K, V]) ReadFromWith(r io.Reader, keyCodec codec.Codec[K], valueCodec codec.Codec[V]) (int64, error) {
+	sr, err := codec.NewReader(r, codec.KindAVLStream)
+	if err != nil {
+		return sr.BytesRead(), fmt.Errorf("%w", err)
+	}
+	builder := &streamBuilder[K, V]{
+		reader:     sr,
+		keyCodec:   keyCodec,
+		valueCodec: valueCodec,
+	}
+	root, err := builder.build(sr.Count())
+	if err != nil {
+		return sr.BytesRead(), fmt.Errorf("%w", err)
+	}
+	if err := sr.Close(); err != nil {
+		return sr.BytesRead(), fmt.Errorf("%w", err)
+	}
+
+	// An empty stream leaves an empty root, as Delete does
+	if root == nil {
+		*tree = AVLTree[K, V]{}
+	} else {
+		*tree = *root
+	}
+	return sr.BytesRead(), nil
+}
+
+// WriteTo writes the entries of the tree in ascending key order to w,
+// using the default codecs.
+func (tree *AVLTree[K, V]) WriteTo(w io.Writer) (int64, error) {
+	return tree.WriteToWith(w, codec.Default[K](), codec.Default[V]())
+}
+
+// WriteToWith writes the entries of the tree in ascending key order to w
+// using the given codecs. Entries are encoded and written one at a time
+// during the in-order traversal, so memory use only grows with the
+// height of the tree.
+func (tree *AVLTree[K, V]) WriteToWith(w io.Writer, keyCodec codec.Codec[K], valueCodec codec.Codec[V]) (int64, error) {
+	sw, err := codec.NewWriter(w, codec.KindAVLStream, uint64(tree.Size()))
+	if err != nil {
+		return sw.BytesWritten(), fmt.Errorf("%w", err)
+	}
+	if err := tree.writeInorder(sw, keyCodec, valueCodec); err != nil {
+		return sw.BytesWritten(), fmt.Errorf("%w", err)
+	}
+	if err := sw.Close(); err != nil {
+		return sw.BytesWritten(), fmt.Errorf("%w", err)
+	}
+	return sw.BytesWritten(), nil
+}
+
+func (tree *AVLTree[K, V]) writeInorder(sw *codec.Writer, keyCodec codec.Codec[K], valueCodec codec.Codec[V]) error {
+	if tree.IsEmpty() {
+		return nil
+	}
+	if err := tree.left.writeInorder(sw, keyCodec, valueCodec); err != nil {
+		return err
+	}
+	err := sw.WriteRecord(func(buf []byte) ([]byte, error) {
+		buf, err := keyCodec.Append(buf, tree.key)
+		if err != nil {
+			return buf, err
+		}
+		return valueCodec.Append(buf, tree.value)
+	})
+	if err != nil {
+		return err
+	}
+	return tree.right.writeInorder(sw, keyCodec, valueCodec)
+}
+
+// streamBuilder builds a balanced tree from entries read in
+// ascending key order.
+type streamBuilder[K cmp.Ordered, V any] struct {
+	reader     *codec.Reader
+	keyCodec   codec.Codec[K]
+	valueCodec codec.Codec[V]
+	last       *K
+}
+
+// build reads n entries and returns them as a balanced subtree. The left
+// subtree is built first so its entries are the first ones to be read.
+func (builder *streamBuilder[K, V]) build(n uint64) (*AVLTree[K, V], error) {
+	if n == 0 {
+		return nil, nil
+	}
+	leftCount := n / 2
+	left, err := builder.build(leftCount)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := builder.next()
+	if err != nil {
+		return nil, err
+	}
+	node.left = left
+
+	node.right, err = builder.build(n - leftCount - 1)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+func (builder *streamBuilder[K, V]) next() (*AVLTree[K, V], error) {
+	record, err := builder.reader.ReadRecord()
+	if err != nil {
+		return nil, err
+	}
+	key, n, err := builder.keyCodec.Decode(record)
+	if err != nil {
+		return nil, err
+	}
+	value, m, err := builder.valueCodec.Decode(record[n:])
+	if err != nil {
+		return nil, err
+	}
+	if n+m != len(record) {
+		return nil, fmt.Errorf("unexpected trailing data in record")
+	}
+	if builder.last != nil && *builder.last >= key {
+		return nil, fmt.Errorf("keys are not in ascending order: %v after %v", key, *builder.last)
+	}
+	builder.last = &key
+	return NewAVLTRoot(key, value), nil
+}
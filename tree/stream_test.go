@@ -0,0 +1,119 @@
+package tree
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+)
+
+func TestAVLTreeWriteToReadFrom(t *testing.T) {
+	tests := []testAVLT[int, int]{
+		{
+			name: "Test stream tree with several nodes",
+			inputKeys: []int{
+				5, 6, 2, 10, 12, 3, 1, 9,
+			},
+			inputVals: []int{
+				5, 6, 2, 10, 12, 3, 1, 9,
+			},
+			wantTreeVal: []int{
+				1, 2, 3, 5, 6, 9, 10, 12,
+			},
+		},
+		{
+			name: "Test stream tree with one node",
+			inputKeys: []int{
+				5,
+			},
+			inputVals: []int{
+				5,
+			},
+			wantTreeVal: []int{
+				5,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			written, err := NewAVLTArray(tt.inputKeys, tt.inputVals).WriteTo(&buf)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			root := NewAVLTRoot(99, 99)
+			read, err := root.ReadFrom(&buf)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if read != written {
+				t.Errorf("actual read = %v, want %v", read, written)
+			}
+
+			got := root.InorderTraversal()
+			if len(got) != len(tt.wantTreeVal) {
+				t.Fatalf("actual length = %v, want length %v", len(got), len(tt.wantTreeVal))
+			}
+			for i, wantVal := range tt.wantTreeVal {
+				if got[i].value != wantVal {
+					t.Errorf("actual = %v, want %v", got[i].value, wantVal)
+				}
+			}
+			for _, node := range got {
+				if node.GetBalance() > 1 || node.GetBalance() < -1 {
+					t.Errorf("node %v is unbalanced", node.key)
+				}
+			}
+		})
+	}
+}
+
+func TestAVLTreeReadFromLarge(t *testing.T) {
+	root := NewAVLTRoot(0, "0")
+	for i := 1; i < 5000; i++ {
+		_ = root.Add(i, "v")
+	}
+
+	var buf bytes.Buffer
+	if _, err := root.WriteTo(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := NewAVLTRoot(0, "")
+	if _, err := got.ReadFrom(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	nodes := got.InorderTraversal()
+	if len(nodes) != 5000 {
+		t.Fatalf("actual length = %v, want length %v", len(nodes), 5000)
+	}
+	for i, node := range nodes {
+		if node.key != i {
+			t.Fatalf("actual = %v, want %v", node.key, i)
+		}
+	}
+	if got.Height() > 13 {
+		t.Errorf("tree height %v is too large", got.Height())
+	}
+}
+
+func TestAVLTreeGob(t *testing.T) {
+	type payload struct {
+		Name  string
+		Index *AVLTree[string, int]
+	}
+
+	var buf bytes.Buffer
+	index := NewAVLTArray([]string{"b", "a", "c"}, []int{2, 1, 3})
+	if err := gob.NewEncoder(&buf).Encode(payload{Name: "index", Index: index}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got payload
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	node, err := got.Index.Find("c")
+	if err != nil || node.value != 3 {
+		t.Errorf("actual = %v (%v), want %v", node, err, 3)
+	}
+}
@@ -2,7 +2,28 @@ package tree
 
 import "cmp"
 
+// Entry is a key and its value, as returned by Page and used
+// by the JSON array form of the trees.
+type Entry[K cmp.Ordered, V any] struct {
+	Key   K `json:"key"`
+	Value V `json:"value"`
+}
+
 type TreeNode[K cmp.Ordered, V any] struct {
 	key   K
 	value V
 }
+
+func (node *TreeNode[K, V]) Key() K {
+	return node.key
+}
+
+// SetValue replaces the value of the entry in place,
+// without changing its key or its position in the tree.
+func (node *TreeNode[K, V]) SetValue(value V) {
+	node.value = value
+}
+
+func (node *TreeNode[K, V]) Value() V {
+	return node.value
+}
@@ -0,0 +1,138 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+)
+
+// ValidationError describes the first node breaking an invariant of the
+// tree. Path lists the steps from the root to the node, e.g. "root.left.right".
+type ValidationError[K cmp.Ordered] struct {
+	Path   string
+	Key    K
+	Reason string
+}
+
+func (err *ValidationError[K]) Error() string {
+	return fmt.Sprintf("invalid node %v at %s: %s", err.Key, err.Path, err.Reason)
+}
+
+// validateNode is what the validator needs from a tree node.
+// A zero N is an empty subtree.
+type validateNode[K cmp.Ordered, V any, N any] interface {
+	comparable
+	children() (N, N)
+	treeNode() *TreeNode[K, V]
+}
+
+// Validate checks that the keys are in binary search order without
+// duplicates, that every node has |balance| <= 1, and that no node is
+// reachable twice, which would mean a cycle or a node shared by two parents.
+// It returns a *ValidationError for the first violation found.
+func (tree *AVLTree[K, V]) Validate() error {
+	// An emptied root is valid, but not one that still has children
+	if tree == nil || (tree.TreeNode == nil && tree.left == nil && tree.right == nil) {
+		return nil
+	}
+	validator := &treeValidator[K, V, *AVLTree[K, V]]{
+		balanced: true,
+		visited:  map[*AVLTree[K, V]]bool{},
+		entries:  map[*TreeNode[K, V]]bool{},
+	}
+	_, err := validator.validate(tree, []string{"root"}, nil, nil)
+	return err
+}
+
+func (tree *AVLTree[K, V]) treeNode() *TreeNode[K, V] {
+	return tree.TreeNode
+}
+
+// Validate checks that the keys are in binary search order without
+// duplicates, and that no node is reachable twice, which would mean a
+// cycle or a node shared by two parents.
+// It returns a *ValidationError for the first violation found.
+func (tree *BinarySearchTree[K, V]) Validate() error {
+	// An emptied root is valid, but not one that still has children
+	if tree == nil || (tree.TreeNode == nil && tree.left == nil && tree.right == nil) {
+		return nil
+	}
+	validator := &treeValidator[K, V, *BinarySearchTree[K, V]]{
+		visited: map[*BinarySearchTree[K, V]]bool{},
+		entries: map[*TreeNode[K, V]]bool{},
+	}
+	_, err := validator.validate(tree, []string{"root"}, nil, nil)
+	return err
+}
+
+func (tree *BinarySearchTree[K, V]) treeNode() *TreeNode[K, V] {
+	return tree.TreeNode
+}
+
+type treeValidator[K cmp.Ordered, V any, N validateNode[K, V, N]] struct {
+	balanced bool
+	visited  map[N]bool
+	entries  map[*TreeNode[K, V]]bool
+}
+
+// validate checks the subtree whose keys must lie strictly between
+// lower and upper (nil means unbounded) and returns its height.
+func (validator *treeValidator[K, V, N]) validate(node N, path []string, lower, upper *K) (int, error) {
+	var empty N
+	if node == empty {
+		return -1, nil
+	}
+
+	entry := node.treeNode()
+	fail := func(key K, format string, args ...any) error {
+		return &ValidationError[K]{
+			Path:   strings.Join(path, "."),
+			Key:    key,
+			Reason: fmt.Sprintf(format, args...),
+		}
+	}
+
+	// Check the node itself before walking into it,
+	// so a cycle does not recurse forever
+	var key K
+	if entry == nil {
+		return 0, fail(key, "node has no key")
+	}
+	key = entry.key
+	if validator.visited[node] {
+		return 0, fail(key, "node is reachable more than once")
+	}
+	validator.visited[node] = true
+	if validator.entries[entry] {
+		return 0, fail(key, "key and value are shared with another node")
+	}
+	validator.entries[entry] = true
+
+	if lower != nil && key <= *lower {
+		if key == *lower {
+			return 0, fail(key, "duplicate key")
+		}
+		return 0, fail(key, "key is not greater than ancestor key %v", *lower)
+	}
+	if upper != nil && key >= *upper {
+		if key == *upper {
+			return 0, fail(key, "duplicate key")
+		}
+		return 0, fail(key, "key is not less than ancestor key %v", *upper)
+	}
+
+	left, right := node.children()
+	leftHeight, err := validator.validate(left, append(path, "left"), lower, &key)
+	if err != nil {
+		return 0, err
+	}
+	rightHeight, err := validator.validate(right, append(path, "right"), &key, upper)
+	if err != nil {
+		return 0, err
+	}
+
+	if balance := leftHeight - rightHeight; validator.balanced && (balance > 1 || balance < -1) {
+		return 0, fail(key, "balance factor %v is out of range [-1, 1]", balance)
+	}
+	return 1 + max(leftHeight, rightHeight), nil
+}
@@ -0,0 +1,111 @@
+package tree
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestAVLTreeValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		corrupt  func(root *AVLTree[int, int])
+		wantPath string
+		wantKey  int
+	}{
+		{
+			name:    "Test validate healthy tree",
+			corrupt: func(root *AVLTree[int, int]) {},
+		},
+		{
+			name: "Test validate key out of order",
+			corrupt: func(root *AVLTree[int, int]) {
+				root.left.right.key = 10
+			},
+			wantPath: "root.left.right",
+			wantKey:  10,
+		},
+		{
+			name: "Test validate duplicate key",
+			corrupt: func(root *AVLTree[int, int]) {
+				root.right.left.key = 6
+			},
+			wantPath: "root.right.left",
+			wantKey:  6,
+		},
+		{
+			name: "Test validate unbalanced node",
+			corrupt: func(root *AVLTree[int, int]) {
+				root.right.right.right = NewAVLTRoot(8, 8)
+				root.right.right.right.right = NewAVLTRoot(9, 9)
+			},
+			wantPath: "root.right.right",
+			wantKey:  7,
+		},
+		{
+			name: "Test validate cycle",
+			corrupt: func(root *AVLTree[int, int]) {
+				root.left.left.left = root
+			},
+			wantPath: "root.left.left.left",
+			wantKey:  4,
+		},
+		{
+			name: "Test validate shared key and value",
+			corrupt: func(root *AVLTree[int, int]) {
+				root.right.right.TreeNode = root.right.TreeNode
+			},
+			wantPath: "root.right.right",
+			wantKey:  6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys := []int{4, 2, 6, 1, 3, 5, 7}
+			root := NewAVLTArray(keys, keys)
+			tt.corrupt(root)
+
+			err := root.Validate()
+			if tt.wantPath == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			var validationErr *ValidationError[int]
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("actual error = %v, want *ValidationError", err)
+			}
+			if validationErr.Path != tt.wantPath || validationErr.Key != tt.wantKey {
+				t.Errorf("actual = %v at %v, want %v at %v", validationErr.Key, validationErr.Path, tt.wantKey, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestBSTreeValidate(t *testing.T) {
+	// A degenerate tree is valid for a BST, but not for an AVL tree
+	keys := []int{1, 2, 3, 4}
+	if err := NewBSTArray(keys, keys).Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	root := NewBSTArray(keys, keys)
+	root.right.right.left = NewBSTRoot(0, 0)
+	err := root.Validate()
+	var validationErr *ValidationError[int]
+	if !errors.As(err, &validationErr) || validationErr.Path != "root.right.right.left" {
+		t.Errorf("actual error = %v, want error at root.right.right.left", err)
+	}
+}
+
+func TestAVLTreeValidateAfterAdd(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	root := NewAVLTRoot(r.Intn(1000), 0)
+	for i := 0; i < 500; i++ {
+		_ = root.Add(r.Intn(1000), i)
+		if err := root.Validate(); err != nil {
+			t.Fatalf("invalid tree after %v adds: %v", i+1, err)
+		}
+	}
+}